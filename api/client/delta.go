@@ -0,0 +1,104 @@
+// Package client holds helpers for consumers of the MetricsCollector service.
+package client
+
+import (
+	"errors"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+var ErrNoKeyframe = errors.New("delta received before the first keyframe")
+
+// DeltaReader reads a CollectMetrics stream opened with delta set and returns every
+// message with the full lists reconstructed, so callers never see a delta.
+type DeltaReader struct {
+	stream  collectorpb.MetricsCollector_CollectMetricsClient
+	traffic list[*collectorpb.TrafficInfo]
+	sockets list[*collectorpb.ListeningSocket]
+	synced  bool
+}
+
+func NewDeltaReader(stream collectorpb.MetricsCollector_CollectMetricsClient) *DeltaReader {
+	return &DeltaReader{
+		stream:  stream,
+		traffic: list[*collectorpb.TrafficInfo]{key: TrafficKey},
+		sockets: list[*collectorpb.ListeningSocket]{key: SocketKey},
+	}
+}
+
+// Recv returns the next message with Trafficinfo and Listeningsocket filled in and Delta cleared.
+func (r *DeltaReader) Recv() (*collectorpb.MetricsResponse, error) {
+	resp, err := r.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return resp, r.Apply(resp)
+}
+
+// Apply folds resp into the reconstructed state and rewrites it as a full message.
+// Messages without a delta, keyframes or not, replace the state.
+func (r *DeltaReader) Apply(resp *collectorpb.MetricsResponse) error {
+	if resp.GetCollector() == nil {
+		resp.Collector = &collectorpb.Collector{}
+	}
+	delta := resp.GetDelta()
+	if delta == nil {
+		r.traffic.reset(resp.Collector.GetTrafficinfo())
+		r.sockets.reset(resp.Collector.GetListeningsocket())
+		r.synced = true
+		return nil
+	}
+	if !r.synced {
+		return ErrNoKeyframe
+	}
+	r.traffic.apply(delta.GetTrafficinfo().GetUpserted(), delta.GetTrafficinfo().GetRemoved())
+	r.sockets.apply(delta.GetListeningsocket().GetUpserted(), delta.GetListeningsocket().GetRemoved())
+	resp.Collector.Trafficinfo = r.traffic.ordered()
+	resp.Collector.Listeningsocket = r.sockets.ordered()
+	resp.Delta = nil
+	return nil
+}
+
+// list keeps keyed entries in the order they first appeared.
+type list[T any] struct {
+	key   func(T) string
+	keys  []string
+	byKey map[string]T
+}
+
+func (l *list[T]) reset(entries []T) {
+	l.keys = l.keys[:0]
+	l.byKey = make(map[string]T, len(entries))
+	l.apply(entries, nil)
+}
+
+func (l *list[T]) apply(upserted []T, removed []string) {
+	for _, entry := range upserted {
+		key := l.key(entry)
+		if _, ok := l.byKey[key]; !ok {
+			l.keys = append(l.keys, key)
+		}
+		l.byKey[key] = entry
+	}
+	if len(removed) == 0 {
+		return
+	}
+	for _, key := range removed {
+		delete(l.byKey, key)
+	}
+	kept := l.keys[:0]
+	for _, key := range l.keys {
+		if _, ok := l.byKey[key]; ok {
+			kept = append(kept, key)
+		}
+	}
+	l.keys = kept
+}
+
+func (l *list[T]) ordered() []T {
+	entries := make([]T, 0, len(l.keys))
+	for _, key := range l.keys {
+		entries = append(entries, l.byKey[key])
+	}
+	return entries
+}
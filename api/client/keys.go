@@ -0,0 +1,18 @@
+package client
+
+import (
+	"fmt"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+// TrafficKey identifies a connection across the messages of a delta stream. The state is part of
+// it because the server keeps connections that differ only by state apart.
+func TrafficKey(x *collectorpb.TrafficInfo) string {
+	return fmt.Sprintf("%s|%s:%d|%s:%d|%s", x.GetProtocol(), x.GetSourceip(), x.GetSourcePort(), x.GetDestip(), x.GetDestPort(), x.GetState())
+}
+
+// SocketKey identifies a listening socket across the messages of a delta stream.
+func SocketKey(x *collectorpb.ListeningSocket) string {
+	return fmt.Sprintf("%s|%d|%d", x.GetProtocol(), x.GetPort(), x.GetPid())
+}
@@ -9,6 +9,8 @@ package collectorpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,13 +22,238 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProcessSortKey int32
+
+const (
+	ProcessSortKey_PROCESS_SORT_CPU     ProcessSortKey = 0
+	ProcessSortKey_PROCESS_SORT_MEMORY  ProcessSortKey = 1
+	ProcessSortKey_PROCESS_SORT_SWAP    ProcessSortKey = 2
+	ProcessSortKey_PROCESS_SORT_READ    ProcessSortKey = 3
+	ProcessSortKey_PROCESS_SORT_WRITE   ProcessSortKey = 4
+	ProcessSortKey_PROCESS_SORT_THREADS ProcessSortKey = 5
+	ProcessSortKey_PROCESS_SORT_FDS     ProcessSortKey = 6
+)
+
+// Enum value maps for ProcessSortKey.
+var (
+	ProcessSortKey_name = map[int32]string{
+		0: "PROCESS_SORT_CPU",
+		1: "PROCESS_SORT_MEMORY",
+		2: "PROCESS_SORT_SWAP",
+		3: "PROCESS_SORT_READ",
+		4: "PROCESS_SORT_WRITE",
+		5: "PROCESS_SORT_THREADS",
+		6: "PROCESS_SORT_FDS",
+	}
+	ProcessSortKey_value = map[string]int32{
+		"PROCESS_SORT_CPU":     0,
+		"PROCESS_SORT_MEMORY":  1,
+		"PROCESS_SORT_SWAP":    2,
+		"PROCESS_SORT_READ":    3,
+		"PROCESS_SORT_WRITE":   4,
+		"PROCESS_SORT_THREADS": 5,
+		"PROCESS_SORT_FDS":     6,
+	}
+)
+
+func (x ProcessSortKey) Enum() *ProcessSortKey {
+	p := new(ProcessSortKey)
+	*p = x
+	return p
+}
+
+func (x ProcessSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProcessSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_pb_metrics_proto_enumTypes[0].Descriptor()
+}
+
+func (ProcessSortKey) Type() protoreflect.EnumType {
+	return &file_api_pb_metrics_proto_enumTypes[0]
+}
+
+func (x ProcessSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProcessSortKey.Descriptor instead.
+func (ProcessSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{0}
+}
+
+// Family numbers match the Collector field they fill.
+type MetricFamily int32
+
+const (
+	MetricFamily_METRIC_FAMILY_UNKNOWN            MetricFamily = 0
+	MetricFamily_METRIC_FAMILY_LOAD_AVERAGE       MetricFamily = 1
+	MetricFamily_METRIC_FAMILY_CPU                MetricFamily = 2
+	MetricFamily_METRIC_FAMILY_DISK               MetricFamily = 3
+	MetricFamily_METRIC_FAMILY_FILESYSTEM         MetricFamily = 4
+	MetricFamily_METRIC_FAMILY_NETWORK_PROTOCOLS  MetricFamily = 5
+	MetricFamily_METRIC_FAMILY_CONNECTIONS        MetricFamily = 6
+	MetricFamily_METRIC_FAMILY_TCP_STATES         MetricFamily = 7
+	MetricFamily_METRIC_FAMILY_LISTENING_SOCKETS  MetricFamily = 8
+	MetricFamily_METRIC_FAMILY_KERNEL_ACTIVITY    MetricFamily = 9
+	MetricFamily_METRIC_FAMILY_PROCESSES          MetricFamily = 10
+	MetricFamily_METRIC_FAMILY_WATCHLIST          MetricFamily = 11
+	MetricFamily_METRIC_FAMILY_PROCESS_EVENTS     MetricFamily = 12
+	MetricFamily_METRIC_FAMILY_PROCESS_STATES     MetricFamily = 13
+	MetricFamily_METRIC_FAMILY_CGROUPS            MetricFamily = 14
+	MetricFamily_METRIC_FAMILY_NAMESPACES         MetricFamily = 15
+	MetricFamily_METRIC_FAMILY_FILE_DESCRIPTORS   MetricFamily = 16
+	MetricFamily_METRIC_FAMILY_SENSORS            MetricFamily = 17
+	MetricFamily_METRIC_FAMILY_STATSD             MetricFamily = 18
+	MetricFamily_METRIC_FAMILY_MEMORY             MetricFamily = 19
+	MetricFamily_METRIC_FAMILY_NETWORK_INTERFACES MetricFamily = 20
+)
+
+// Enum value maps for MetricFamily.
+var (
+	MetricFamily_name = map[int32]string{
+		0:  "METRIC_FAMILY_UNKNOWN",
+		1:  "METRIC_FAMILY_LOAD_AVERAGE",
+		2:  "METRIC_FAMILY_CPU",
+		3:  "METRIC_FAMILY_DISK",
+		4:  "METRIC_FAMILY_FILESYSTEM",
+		5:  "METRIC_FAMILY_NETWORK_PROTOCOLS",
+		6:  "METRIC_FAMILY_CONNECTIONS",
+		7:  "METRIC_FAMILY_TCP_STATES",
+		8:  "METRIC_FAMILY_LISTENING_SOCKETS",
+		9:  "METRIC_FAMILY_KERNEL_ACTIVITY",
+		10: "METRIC_FAMILY_PROCESSES",
+		11: "METRIC_FAMILY_WATCHLIST",
+		12: "METRIC_FAMILY_PROCESS_EVENTS",
+		13: "METRIC_FAMILY_PROCESS_STATES",
+		14: "METRIC_FAMILY_CGROUPS",
+		15: "METRIC_FAMILY_NAMESPACES",
+		16: "METRIC_FAMILY_FILE_DESCRIPTORS",
+		17: "METRIC_FAMILY_SENSORS",
+		18: "METRIC_FAMILY_STATSD",
+		19: "METRIC_FAMILY_MEMORY",
+		20: "METRIC_FAMILY_NETWORK_INTERFACES",
+	}
+	MetricFamily_value = map[string]int32{
+		"METRIC_FAMILY_UNKNOWN":            0,
+		"METRIC_FAMILY_LOAD_AVERAGE":       1,
+		"METRIC_FAMILY_CPU":                2,
+		"METRIC_FAMILY_DISK":               3,
+		"METRIC_FAMILY_FILESYSTEM":         4,
+		"METRIC_FAMILY_NETWORK_PROTOCOLS":  5,
+		"METRIC_FAMILY_CONNECTIONS":        6,
+		"METRIC_FAMILY_TCP_STATES":         7,
+		"METRIC_FAMILY_LISTENING_SOCKETS":  8,
+		"METRIC_FAMILY_KERNEL_ACTIVITY":    9,
+		"METRIC_FAMILY_PROCESSES":          10,
+		"METRIC_FAMILY_WATCHLIST":          11,
+		"METRIC_FAMILY_PROCESS_EVENTS":     12,
+		"METRIC_FAMILY_PROCESS_STATES":     13,
+		"METRIC_FAMILY_CGROUPS":            14,
+		"METRIC_FAMILY_NAMESPACES":         15,
+		"METRIC_FAMILY_FILE_DESCRIPTORS":   16,
+		"METRIC_FAMILY_SENSORS":            17,
+		"METRIC_FAMILY_STATSD":             18,
+		"METRIC_FAMILY_MEMORY":             19,
+		"METRIC_FAMILY_NETWORK_INTERFACES": 20,
+	}
+)
+
+func (x MetricFamily) Enum() *MetricFamily {
+	p := new(MetricFamily)
+	*p = x
+	return p
+}
+
+func (x MetricFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_pb_metrics_proto_enumTypes[1].Descriptor()
+}
+
+func (MetricFamily) Type() protoreflect.EnumType {
+	return &file_api_pb_metrics_proto_enumTypes[1]
+}
+
+func (x MetricFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricFamily.Descriptor instead.
+func (MetricFamily) EnumDescriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{1}
+}
+
+type ProcessEventType int32
+
+const (
+	ProcessEventType_PROCESS_EVENT_UNKNOWN ProcessEventType = 0
+	ProcessEventType_PROCESS_EVENT_FORK    ProcessEventType = 1
+	ProcessEventType_PROCESS_EVENT_EXEC    ProcessEventType = 2
+	ProcessEventType_PROCESS_EVENT_EXIT    ProcessEventType = 3
+)
+
+// Enum value maps for ProcessEventType.
+var (
+	ProcessEventType_name = map[int32]string{
+		0: "PROCESS_EVENT_UNKNOWN",
+		1: "PROCESS_EVENT_FORK",
+		2: "PROCESS_EVENT_EXEC",
+		3: "PROCESS_EVENT_EXIT",
+	}
+	ProcessEventType_value = map[string]int32{
+		"PROCESS_EVENT_UNKNOWN": 0,
+		"PROCESS_EVENT_FORK":    1,
+		"PROCESS_EVENT_EXEC":    2,
+		"PROCESS_EVENT_EXIT":    3,
+	}
+)
+
+func (x ProcessEventType) Enum() *ProcessEventType {
+	p := new(ProcessEventType)
+	*p = x
+	return p
+}
+
+func (x ProcessEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProcessEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_pb_metrics_proto_enumTypes[2].Descriptor()
+}
+
+func (ProcessEventType) Type() protoreflect.EnumType {
+	return &file_api_pb_metrics_proto_enumTypes[2]
+}
+
+func (x ProcessEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProcessEventType.Descriptor instead.
+func (ProcessEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{2}
+}
+
 type MetricsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NSecond int32 `protobuf:"varint,1,opt,name=n_second,json=nSecond,proto3" json:"n_second,omitempty"`
-	MSecond int32 `protobuf:"varint,2,opt,name=m_second,json=mSecond,proto3" json:"m_second,omitempty"`
+	NSecond      int32          `protobuf:"varint,1,opt,name=n_second,json=nSecond,proto3" json:"n_second,omitempty"`
+	MSecond      int32          `protobuf:"varint,2,opt,name=m_second,json=mSecond,proto3" json:"m_second,omitempty"`
+	TopProcesses int32          `protobuf:"varint,3,opt,name=top_processes,json=topProcesses,proto3" json:"top_processes,omitempty"`
+	ProcessSort  ProcessSortKey `protobuf:"varint,4,opt,name=process_sort,json=processSort,proto3,enum=collector.ProcessSortKey" json:"process_sort,omitempty"`
+	// Empty families selects every family enabled in the server config.
+	Families       []MetricFamily `protobuf:"varint,5,rep,packed,name=families,proto3,enum=collector.MetricFamily" json:"families,omitempty"`
+	TopConnections int32          `protobuf:"varint,6,opt,name=top_connections,json=topConnections,proto3" json:"top_connections,omitempty"`
+	// Delta streams send a keyframe every keyframe_interval messages and only changes in between.
+	Delta            bool  `protobuf:"varint,7,opt,name=delta,proto3" json:"delta,omitempty"`
+	KeyframeInterval int32 `protobuf:"varint,8,opt,name=keyframe_interval,json=keyframeInterval,proto3" json:"keyframe_interval,omitempty"`
 }
 
 func (x *MetricsRequest) Reset() {
@@ -75,16 +302,62 @@ func (x *MetricsRequest) GetMSecond() int32 {
 	return 0
 }
 
-type MetricsResponse struct {
+func (x *MetricsRequest) GetTopProcesses() int32 {
+	if x != nil {
+		return x.TopProcesses
+	}
+	return 0
+}
+
+func (x *MetricsRequest) GetProcessSort() ProcessSortKey {
+	if x != nil {
+		return x.ProcessSort
+	}
+	return ProcessSortKey_PROCESS_SORT_CPU
+}
+
+func (x *MetricsRequest) GetFamilies() []MetricFamily {
+	if x != nil {
+		return x.Families
+	}
+	return nil
+}
+
+func (x *MetricsRequest) GetTopConnections() int32 {
+	if x != nil {
+		return x.TopConnections
+	}
+	return 0
+}
+
+func (x *MetricsRequest) GetDelta() bool {
+	if x != nil {
+		return x.Delta
+	}
+	return false
+}
+
+func (x *MetricsRequest) GetKeyframeInterval() int32 {
+	if x != nil {
+		return x.KeyframeInterval
+	}
+	return 0
+}
+
+type SnapshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collector *Collector `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	WindowSeconds  int32          `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	TopProcesses   int32          `protobuf:"varint,2,opt,name=top_processes,json=topProcesses,proto3" json:"top_processes,omitempty"`
+	ProcessSort    ProcessSortKey `protobuf:"varint,3,opt,name=process_sort,json=processSort,proto3,enum=collector.ProcessSortKey" json:"process_sort,omitempty"`
+	Families       []MetricFamily `protobuf:"varint,4,rep,packed,name=families,proto3,enum=collector.MetricFamily" json:"families,omitempty"`
+	TopConnections int32          `protobuf:"varint,5,opt,name=top_connections,json=topConnections,proto3" json:"top_connections,omitempty"`
 }
 
-func (x *MetricsResponse) Reset() {
-	*x = MetricsResponse{}
+func (x *SnapshotRequest) Reset() {
+	*x = SnapshotRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -92,13 +365,13 @@ func (x *MetricsResponse) Reset() {
 	}
 }
 
-func (x *MetricsResponse) String() string {
+func (x *SnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsResponse) ProtoMessage() {}
+func (*SnapshotRequest) ProtoMessage() {}
 
-func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
+func (x *SnapshotRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -110,30 +383,66 @@ func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsResponse.ProtoReflect.Descriptor instead.
-func (*MetricsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MetricsResponse) GetCollector() *Collector {
+func (x *SnapshotRequest) GetWindowSeconds() int32 {
 	if x != nil {
-		return x.Collector
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *SnapshotRequest) GetTopProcesses() int32 {
+	if x != nil {
+		return x.TopProcesses
+	}
+	return 0
+}
+
+func (x *SnapshotRequest) GetProcessSort() ProcessSortKey {
+	if x != nil {
+		return x.ProcessSort
+	}
+	return ProcessSortKey_PROCESS_SORT_CPU
+}
+
+func (x *SnapshotRequest) GetFamilies() []MetricFamily {
+	if x != nil {
+		return x.Families
 	}
 	return nil
 }
 
-type LoadAverage struct {
+func (x *SnapshotRequest) GetTopConnections() int32 {
+	if x != nil {
+		return x.TopConnections
+	}
+	return 0
+}
+
+type MetricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OneMinute      float64 `protobuf:"fixed64,1,opt,name=one_minute,json=oneMinute,proto3" json:"one_minute,omitempty"`
-	FiveMinutes    float64 `protobuf:"fixed64,2,opt,name=five_minutes,json=fiveMinutes,proto3" json:"five_minutes,omitempty"`
-	FifteenMinutes float64 `protobuf:"fixed64,3,opt,name=fifteen_minutes,json=fifteenMinutes,proto3" json:"fifteen_minutes,omitempty"`
+	Collector *Collector `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	// Collection times of the first and the last sample averaged.
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Samples     int64                  `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	// Sequence of the last sample, a jump larger than samples means some were lost.
+	Sequence     int64           `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	FamilyStatus []*FamilyStatus `protobuf:"bytes,6,rep,name=family_status,json=familyStatus,proto3" json:"family_status,omitempty"`
+	Hostname     string          `protobuf:"bytes,7,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Keyframe     bool            `protobuf:"varint,8,opt,name=keyframe,proto3" json:"keyframe,omitempty"`
+	Delta        *CollectorDelta `protobuf:"bytes,9,opt,name=delta,proto3" json:"delta,omitempty"`
 }
 
-func (x *LoadAverage) Reset() {
-	*x = LoadAverage{}
+func (x *MetricsResponse) Reset() {
+	*x = MetricsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -141,13 +450,13 @@ func (x *LoadAverage) Reset() {
 	}
 }
 
-func (x *LoadAverage) String() string {
+func (x *MetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoadAverage) ProtoMessage() {}
+func (*MetricsResponse) ProtoMessage() {}
 
-func (x *LoadAverage) ProtoReflect() protoreflect.Message {
+func (x *MetricsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,44 +468,85 @@ func (x *LoadAverage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoadAverage.ProtoReflect.Descriptor instead.
-func (*LoadAverage) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsResponse.ProtoReflect.Descriptor instead.
+func (*MetricsResponse) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *LoadAverage) GetOneMinute() float64 {
+func (x *MetricsResponse) GetCollector() *Collector {
 	if x != nil {
-		return x.OneMinute
+		return x.Collector
 	}
-	return 0
+	return nil
 }
 
-func (x *LoadAverage) GetFiveMinutes() float64 {
+func (x *MetricsResponse) GetWindowStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FiveMinutes
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *MetricsResponse) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *MetricsResponse) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
 	}
 	return 0
 }
 
-func (x *LoadAverage) GetFifteenMinutes() float64 {
+func (x *MetricsResponse) GetSequence() int64 {
 	if x != nil {
-		return x.FifteenMinutes
+		return x.Sequence
 	}
 	return 0
 }
 
-type CPUUsage struct {
+func (x *MetricsResponse) GetFamilyStatus() []*FamilyStatus {
+	if x != nil {
+		return x.FamilyStatus
+	}
+	return nil
+}
+
+func (x *MetricsResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *MetricsResponse) GetKeyframe() bool {
+	if x != nil {
+		return x.Keyframe
+	}
+	return false
+}
+
+func (x *MetricsResponse) GetDelta() *CollectorDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+type TrafficInfoDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserMode   float64 `protobuf:"fixed64,1,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
-	SystemMode float64 `protobuf:"fixed64,2,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
-	Idle       float64 `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle,omitempty"`
+	Upserted []*TrafficInfo `protobuf:"bytes,1,rep,name=upserted,proto3" json:"upserted,omitempty"`
+	Removed  []string       `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
 }
 
-func (x *CPUUsage) Reset() {
-	*x = CPUUsage{}
+func (x *TrafficInfoDelta) Reset() {
+	*x = TrafficInfoDelta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,13 +554,13 @@ func (x *CPUUsage) Reset() {
 	}
 }
 
-func (x *CPUUsage) String() string {
+func (x *TrafficInfoDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CPUUsage) ProtoMessage() {}
+func (*TrafficInfoDelta) ProtoMessage() {}
 
-func (x *CPUUsage) ProtoReflect() protoreflect.Message {
+func (x *TrafficInfoDelta) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -222,44 +572,36 @@ func (x *CPUUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CPUUsage.ProtoReflect.Descriptor instead.
-func (*CPUUsage) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrafficInfoDelta.ProtoReflect.Descriptor instead.
+func (*TrafficInfoDelta) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CPUUsage) GetUserMode() float64 {
-	if x != nil {
-		return x.UserMode
-	}
-	return 0
-}
-
-func (x *CPUUsage) GetSystemMode() float64 {
+func (x *TrafficInfoDelta) GetUpserted() []*TrafficInfo {
 	if x != nil {
-		return x.SystemMode
+		return x.Upserted
 	}
-	return 0
+	return nil
 }
 
-func (x *CPUUsage) GetIdle() float64 {
+func (x *TrafficInfoDelta) GetRemoved() []string {
 	if x != nil {
-		return x.Idle
+		return x.Removed
 	}
-	return 0
+	return nil
 }
 
-type DiskUsage struct {
+type ListeningSocketDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tps      float64 `protobuf:"fixed64,2,opt,name=tps,proto3" json:"tps,omitempty"`
-	Kbpersec float64 `protobuf:"fixed64,3,opt,name=kbpersec,proto3" json:"kbpersec,omitempty"`
+	Upserted []*ListeningSocket `protobuf:"bytes,1,rep,name=upserted,proto3" json:"upserted,omitempty"`
+	Removed  []string           `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
 }
 
-func (x *DiskUsage) Reset() {
-	*x = DiskUsage{}
+func (x *ListeningSocketDelta) Reset() {
+	*x = ListeningSocketDelta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -267,13 +609,13 @@ func (x *DiskUsage) Reset() {
 	}
 }
 
-func (x *DiskUsage) String() string {
+func (x *ListeningSocketDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskUsage) ProtoMessage() {}
+func (*ListeningSocketDelta) ProtoMessage() {}
 
-func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+func (x *ListeningSocketDelta) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -285,46 +627,38 @@ func (x *DiskUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
-func (*DiskUsage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListeningSocketDelta.ProtoReflect.Descriptor instead.
+func (*ListeningSocketDelta) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *DiskUsage) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *DiskUsage) GetTps() float64 {
+func (x *ListeningSocketDelta) GetUpserted() []*ListeningSocket {
 	if x != nil {
-		return x.Tps
+		return x.Upserted
 	}
-	return 0
+	return nil
 }
 
-func (x *DiskUsage) GetKbpersec() float64 {
+func (x *ListeningSocketDelta) GetRemoved() []string {
 	if x != nil {
-		return x.Kbpersec
+		return x.Removed
 	}
-	return 0
+	return nil
 }
 
-type FileSystemUsage struct {
+// CollectorDelta holds the changes of the keyed lists since the previous message,
+// the other Collector fields are always sent in full.
+type CollectorDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileSystem   string  `protobuf:"bytes,1,opt,name=file_system,json=fileSystem,proto3" json:"file_system,omitempty"`
-	Usedmb       float64 `protobuf:"fixed64,2,opt,name=usedmb,proto3" json:"usedmb,omitempty"`
-	UsedPercent  float64 `protobuf:"fixed64,3,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
-	UsedInode    float64 `protobuf:"fixed64,4,opt,name=used_inode,json=usedInode,proto3" json:"used_inode,omitempty"`
-	InodePercent float64 `protobuf:"fixed64,5,opt,name=inode_percent,json=inodePercent,proto3" json:"inode_percent,omitempty"`
+	Trafficinfo     *TrafficInfoDelta     `protobuf:"bytes,1,opt,name=trafficinfo,proto3" json:"trafficinfo,omitempty"`
+	Listeningsocket *ListeningSocketDelta `protobuf:"bytes,2,opt,name=listeningsocket,proto3" json:"listeningsocket,omitempty"`
 }
 
-func (x *FileSystemUsage) Reset() {
-	*x = FileSystemUsage{}
+func (x *CollectorDelta) Reset() {
+	*x = CollectorDelta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,13 +666,13 @@ func (x *FileSystemUsage) Reset() {
 	}
 }
 
-func (x *FileSystemUsage) String() string {
+func (x *CollectorDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileSystemUsage) ProtoMessage() {}
+func (*CollectorDelta) ProtoMessage() {}
 
-func (x *FileSystemUsage) ProtoReflect() protoreflect.Message {
+func (x *CollectorDelta) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -350,58 +684,37 @@ func (x *FileSystemUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileSystemUsage.ProtoReflect.Descriptor instead.
-func (*FileSystemUsage) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectorDelta.ProtoReflect.Descriptor instead.
+func (*CollectorDelta) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *FileSystemUsage) GetFileSystem() string {
+func (x *CollectorDelta) GetTrafficinfo() *TrafficInfoDelta {
 	if x != nil {
-		return x.FileSystem
+		return x.Trafficinfo
 	}
-	return ""
+	return nil
 }
 
-func (x *FileSystemUsage) GetUsedmb() float64 {
+func (x *CollectorDelta) GetListeningsocket() *ListeningSocketDelta {
 	if x != nil {
-		return x.Usedmb
+		return x.Listeningsocket
 	}
-	return 0
-}
-
-func (x *FileSystemUsage) GetUsedPercent() float64 {
-	if x != nil {
-		return x.UsedPercent
-	}
-	return 0
-}
-
-func (x *FileSystemUsage) GetUsedInode() float64 {
-	if x != nil {
-		return x.UsedInode
-	}
-	return 0
-}
-
-func (x *FileSystemUsage) GetInodePercent() float64 {
-	if x != nil {
-		return x.InodePercent
-	}
-	return 0
+	return nil
 }
 
-type NetworkProtocol struct {
+type FamilyStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Bytes    int64   `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Percent  float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Family   MetricFamily         `protobuf:"varint,1,opt,name=family,proto3,enum=collector.MetricFamily" json:"family,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Error    string               `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *NetworkProtocol) Reset() {
-	*x = NetworkProtocol{}
+func (x *FamilyStatus) Reset() {
+	*x = FamilyStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,13 +722,13 @@ func (x *NetworkProtocol) Reset() {
 	}
 }
 
-func (x *NetworkProtocol) String() string {
+func (x *FamilyStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkProtocol) ProtoMessage() {}
+func (*FamilyStatus) ProtoMessage() {}
 
-func (x *NetworkProtocol) ProtoReflect() protoreflect.Message {
+func (x *FamilyStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,49 +740,51 @@ func (x *NetworkProtocol) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkProtocol.ProtoReflect.Descriptor instead.
-func (*NetworkProtocol) Descriptor() ([]byte, []int) {
+// Deprecated: Use FamilyStatus.ProtoReflect.Descriptor instead.
+func (*FamilyStatus) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *NetworkProtocol) GetProtocol() string {
+func (x *FamilyStatus) GetFamily() MetricFamily {
 	if x != nil {
-		return x.Protocol
+		return x.Family
 	}
-	return ""
+	return MetricFamily_METRIC_FAMILY_UNKNOWN
 }
 
-func (x *NetworkProtocol) GetBytes() int64 {
+func (x *FamilyStatus) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Bytes
+		return x.Duration
 	}
-	return 0
+	return nil
 }
 
-func (x *NetworkProtocol) GetPercent() float64 {
+func (x *FamilyStatus) GetError() string {
 	if x != nil {
-		return x.Percent
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type TrafficInfo struct {
+type QueryRangeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sourceip   string  `protobuf:"bytes,1,opt,name=sourceip,proto3" json:"sourceip,omitempty"`
-	SourcePort int64   `protobuf:"varint,2,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	Destip     string  `protobuf:"bytes,3,opt,name=destip,proto3" json:"destip,omitempty"`
-	DestPort   int64   `protobuf:"varint,4,opt,name=dest_port,json=destPort,proto3" json:"dest_port,omitempty"`
-	Protocol   string  `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Bps        float64 `protobuf:"fixed64,6,opt,name=bps,proto3" json:"bps,omitempty"`
-	Bytes      int64   `protobuf:"varint,7,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
-	State      string  `protobuf:"bytes,8,opt,name=State,proto3" json:"State,omitempty"`
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Defaults to now.
+	End *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Zero returns every raw sample and is refused for ranges over 15 minutes, otherwise
+	// samples are averaged per step.
+	Step           *durationpb.Duration `protobuf:"bytes,3,opt,name=step,proto3" json:"step,omitempty"`
+	Families       []MetricFamily       `protobuf:"varint,4,rep,packed,name=families,proto3,enum=collector.MetricFamily" json:"families,omitempty"`
+	TopProcesses   int32                `protobuf:"varint,5,opt,name=top_processes,json=topProcesses,proto3" json:"top_processes,omitempty"`
+	ProcessSort    ProcessSortKey       `protobuf:"varint,6,opt,name=process_sort,json=processSort,proto3,enum=collector.ProcessSortKey" json:"process_sort,omitempty"`
+	TopConnections int32                `protobuf:"varint,7,opt,name=top_connections,json=topConnections,proto3" json:"top_connections,omitempty"`
 }
 
-func (x *TrafficInfo) Reset() {
-	*x = TrafficInfo{}
+func (x *QueryRangeRequest) Reset() {
+	*x = QueryRangeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,13 +792,13 @@ func (x *TrafficInfo) Reset() {
 	}
 }
 
-func (x *TrafficInfo) String() string {
+func (x *QueryRangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficInfo) ProtoMessage() {}
+func (*QueryRangeRequest) ProtoMessage() {}
 
-func (x *TrafficInfo) ProtoReflect() protoreflect.Message {
+func (x *QueryRangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,81 +810,71 @@ func (x *TrafficInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficInfo.ProtoReflect.Descriptor instead.
-func (*TrafficInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryRangeRequest.ProtoReflect.Descriptor instead.
+func (*QueryRangeRequest) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TrafficInfo) GetSourceip() string {
-	if x != nil {
-		return x.Sourceip
-	}
-	return ""
-}
-
-func (x *TrafficInfo) GetSourcePort() int64 {
+func (x *QueryRangeRequest) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SourcePort
+		return x.Start
 	}
-	return 0
+	return nil
 }
 
-func (x *TrafficInfo) GetDestip() string {
+func (x *QueryRangeRequest) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Destip
+		return x.End
 	}
-	return ""
+	return nil
 }
 
-func (x *TrafficInfo) GetDestPort() int64 {
+func (x *QueryRangeRequest) GetStep() *durationpb.Duration {
 	if x != nil {
-		return x.DestPort
+		return x.Step
 	}
-	return 0
+	return nil
 }
 
-func (x *TrafficInfo) GetProtocol() string {
+func (x *QueryRangeRequest) GetFamilies() []MetricFamily {
 	if x != nil {
-		return x.Protocol
+		return x.Families
 	}
-	return ""
+	return nil
 }
 
-func (x *TrafficInfo) GetBps() float64 {
+func (x *QueryRangeRequest) GetTopProcesses() int32 {
 	if x != nil {
-		return x.Bps
+		return x.TopProcesses
 	}
 	return 0
 }
 
-func (x *TrafficInfo) GetBytes() int64 {
+func (x *QueryRangeRequest) GetProcessSort() ProcessSortKey {
 	if x != nil {
-		return x.Bytes
+		return x.ProcessSort
 	}
-	return 0
+	return ProcessSortKey_PROCESS_SORT_CPU
 }
 
-func (x *TrafficInfo) GetState() string {
+func (x *QueryRangeRequest) GetTopConnections() int32 {
 	if x != nil {
-		return x.State
+		return x.TopConnections
 	}
-	return ""
+	return 0
 }
 
-type ListeningSocket struct {
+// Steps without samples are left out, the window of each point tells where it lies.
+type QueryRangeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command  string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
-	Pid      int64  `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
-	User     string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Protocol string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Port     int64  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Points []*MetricsResponse `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
 }
 
-func (x *ListeningSocket) Reset() {
-	*x = ListeningSocket{}
+func (x *QueryRangeResponse) Reset() {
+	*x = QueryRangeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_pb_metrics_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,13 +882,13 @@ func (x *ListeningSocket) Reset() {
 	}
 }
 
-func (x *ListeningSocket) String() string {
+func (x *QueryRangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListeningSocket) ProtoMessage() {}
+func (*QueryRangeResponse) ProtoMessage() {}
 
-func (x *ListeningSocket) ProtoReflect() protoreflect.Message {
+func (x *QueryRangeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_pb_metrics_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,72 +900,83 @@ func (x *ListeningSocket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListeningSocket.ProtoReflect.Descriptor instead.
-func (*ListeningSocket) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryRangeResponse.ProtoReflect.Descriptor instead.
+func (*QueryRangeResponse) Descriptor() ([]byte, []int) {
 	return file_api_pb_metrics_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListeningSocket) GetCommand() string {
+func (x *QueryRangeResponse) GetPoints() []*MetricsResponse {
 	if x != nil {
-		return x.Command
+		return x.Points
 	}
-	return ""
+	return nil
 }
 
-func (x *ListeningSocket) GetPid() int64 {
-	if x != nil {
-		return x.Pid
-	}
-	return 0
+type CapabilitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ListeningSocket) GetUser() string {
-	if x != nil {
-		return x.User
+func (x *CapabilitiesRequest) Reset() {
+	*x = CapabilitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ListeningSocket) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
-	}
-	return ""
+func (x *CapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListeningSocket) GetPort() int64 {
-	if x != nil {
-		return x.Port
+func (*CapabilitiesRequest) ProtoMessage() {}
+
+func (x *CapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type TCPStates struct {
+// Deprecated: Use CapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*CapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{9}
+}
+
+type FieldInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
 }
 
-func (x *TCPStates) Reset() {
-	*x = TCPStates{}
+func (x *FieldInfo) Reset() {
+	*x = FieldInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_metrics_proto_msgTypes[9]
+		mi := &file_api_pb_metrics_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TCPStates) String() string {
+func (x *FieldInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TCPStates) ProtoMessage() {}
+func (*FieldInfo) ProtoMessage() {}
 
-func (x *TCPStates) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_metrics_proto_msgTypes[9]
+func (x *FieldInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,57 +987,60 @@ func (x *TCPStates) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TCPStates.ProtoReflect.Descriptor instead.
-func (*TCPStates) Descriptor() ([]byte, []int) {
-	return file_api_pb_metrics_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use FieldInfo.ProtoReflect.Descriptor instead.
+func (*FieldInfo) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TCPStates) GetState() string {
+func (x *FieldInfo) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TCPStates) GetCount() int64 {
+func (x *FieldInfo) GetType() string {
 	if x != nil {
-		return x.Count
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-type Collector struct {
+func (x *FieldInfo) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type FamilyCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loadaverage     *LoadAverage       `protobuf:"bytes,1,opt,name=loadaverage,proto3" json:"loadaverage,omitempty"`
-	Cpuusage        *CPUUsage          `protobuf:"bytes,2,opt,name=cpuusage,proto3" json:"cpuusage,omitempty"`
-	Diskusage       []*DiskUsage       `protobuf:"bytes,3,rep,name=diskusage,proto3" json:"diskusage,omitempty"`
-	Filesystemusage []*FileSystemUsage `protobuf:"bytes,4,rep,name=filesystemusage,proto3" json:"filesystemusage,omitempty"`
-	Networkprotocol []*NetworkProtocol `protobuf:"bytes,5,rep,name=networkprotocol,proto3" json:"networkprotocol,omitempty"`
-	Trafficinfo     []*TrafficInfo     `protobuf:"bytes,6,rep,name=trafficinfo,proto3" json:"trafficinfo,omitempty"`
-	Tcpstates       []*TCPStates       `protobuf:"bytes,7,rep,name=tcpstates,proto3" json:"tcpstates,omitempty"`
-	Listeningsocket []*ListeningSocket `protobuf:"bytes,8,rep,name=listeningsocket,proto3" json:"listeningsocket,omitempty"`
+	Family    MetricFamily `protobuf:"varint,1,opt,name=family,proto3,enum=collector.MetricFamily" json:"family,omitempty"`
+	Enabled   bool         `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Supported bool         `protobuf:"varint,3,opt,name=supported,proto3" json:"supported,omitempty"`
+	Fields    []*FieldInfo `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-func (x *Collector) Reset() {
-	*x = Collector{}
+func (x *FamilyCapability) Reset() {
+	*x = FamilyCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_pb_metrics_proto_msgTypes[10]
+		mi := &file_api_pb_metrics_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Collector) String() string {
+func (x *FamilyCapability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Collector) ProtoMessage() {}
+func (*FamilyCapability) ProtoMessage() {}
 
-func (x *Collector) ProtoReflect() protoreflect.Message {
-	mi := &file_api_pb_metrics_proto_msgTypes[10]
+func (x *FamilyCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,226 +1051,4370 @@ func (x *Collector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Collector.ProtoReflect.Descriptor instead.
-func (*Collector) Descriptor() ([]byte, []int) {
-	return file_api_pb_metrics_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use FamilyCapability.ProtoReflect.Descriptor instead.
+func (*FamilyCapability) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Collector) GetLoadaverage() *LoadAverage {
+func (x *FamilyCapability) GetFamily() MetricFamily {
 	if x != nil {
-		return x.Loadaverage
+		return x.Family
 	}
-	return nil
+	return MetricFamily_METRIC_FAMILY_UNKNOWN
 }
 
-func (x *Collector) GetCpuusage() *CPUUsage {
+func (x *FamilyCapability) GetEnabled() bool {
 	if x != nil {
-		return x.Cpuusage
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *Collector) GetDiskusage() []*DiskUsage {
+func (x *FamilyCapability) GetSupported() bool {
 	if x != nil {
-		return x.Diskusage
+		return x.Supported
 	}
-	return nil
+	return false
 }
 
-func (x *Collector) GetFilesystemusage() []*FileSystemUsage {
+func (x *FamilyCapability) GetFields() []*FieldInfo {
 	if x != nil {
-		return x.Filesystemusage
+		return x.Fields
 	}
 	return nil
 }
 
-func (x *Collector) GetNetworkprotocol() []*NetworkProtocol {
+type Capabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Families    []*FamilyCapability  `protobuf:"bytes,1,rep,name=families,proto3" json:"families,omitempty"`
+	MinInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`
+	MaxInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	// Longest averaging window the server keeps samples for.
+	MaxWindow *durationpb.Duration `protobuf:"bytes,4,opt,name=max_window,json=maxWindow,proto3" json:"max_window,omitempty"`
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Capabilities) GetFamilies() []*FamilyCapability {
 	if x != nil {
-		return x.Networkprotocol
+		return x.Families
 	}
 	return nil
 }
 
-func (x *Collector) GetTrafficinfo() []*TrafficInfo {
+func (x *Capabilities) GetMinInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Trafficinfo
+		return x.MinInterval
 	}
 	return nil
 }
 
-func (x *Collector) GetTcpstates() []*TCPStates {
+func (x *Capabilities) GetMaxInterval() *durationpb.Duration {
 	if x != nil {
-		return x.Tcpstates
+		return x.MaxInterval
 	}
 	return nil
 }
 
-func (x *Collector) GetListeningsocket() []*ListeningSocket {
+func (x *Capabilities) GetMaxWindow() *durationpb.Duration {
 	if x != nil {
-		return x.Listeningsocket
+		return x.MaxWindow
 	}
 	return nil
 }
 
-var File_api_pb_metrics_proto protoreflect.FileDescriptor
-
-var file_api_pb_metrics_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x22, 0x46, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x22, 0x78, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x5f, 0x6d, 0x69, 0x6e,
-	0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x66, 0x69, 0x66, 0x74,
-	0x65, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x08, 0x43, 0x50,
-	0x55, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x74, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
-	0x62, 0x70, 0x65, 0x72, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6b,
-	0x62, 0x70, 0x65, 0x72, 0x73, 0x65, 0x63, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x64, 0x6d, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x64, 0x6d, 0x62, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64,
-	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f,
-	0x69, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x75, 0x73, 0x65,
-	0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69,
-	0x6e, 0x6f, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x69, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x73, 0x74, 0x69, 0x70, 0x12,
-	0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x62, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x37, 0x0a, 0x09, 0x54, 0x43,
-	0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xea, 0x03, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x12, 0x38, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0b,
-	0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x63,
-	0x70, 0x75, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x50, 0x55, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x08, 0x63, 0x70, 0x75, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x09,
-	0x64, 0x69, 0x73, 0x6b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x6b,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x75, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x38, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x43, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x09, 0x74, 0x63, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x32, 0x5d, 0x0a, 0x10, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42,
-	0x10, 0x5a, 0x0e, 0x2e, 0x2f, 0x3b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type HostInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var (
-	file_api_pb_metrics_proto_rawDescOnce sync.Once
-	file_api_pb_metrics_proto_rawDescData = file_api_pb_metrics_proto_rawDesc
-)
-
-func file_api_pb_metrics_proto_rawDescGZIP() []byte {
-	file_api_pb_metrics_proto_rawDescOnce.Do(func() {
-		file_api_pb_metrics_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_pb_metrics_proto_rawDescData)
-	})
-	return file_api_pb_metrics_proto_rawDescData
+func (x *HostInfoRequest) Reset() {
+	*x = HostInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_api_pb_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_api_pb_metrics_proto_goTypes = []interface{}{
-	(*MetricsRequest)(nil),  // 0: collector.MetricsRequest
-	(*MetricsResponse)(nil), // 1: collector.MetricsResponse
-	(*LoadAverage)(nil),     // 2: collector.LoadAverage
-	(*CPUUsage)(nil),        // 3: collector.CPUUsage
-	(*DiskUsage)(nil),       // 4: collector.DiskUsage
-	(*FileSystemUsage)(nil), // 5: collector.FileSystemUsage
-	(*NetworkProtocol)(nil), // 6: collector.NetworkProtocol
-	(*TrafficInfo)(nil),     // 7: collector.TrafficInfo
-	(*ListeningSocket)(nil), // 8: collector.ListeningSocket
-	(*TCPStates)(nil),       // 9: collector.TCPStates
-	(*Collector)(nil),       // 10: collector.Collector
-}
-var file_api_pb_metrics_proto_depIdxs = []int32{
-	10, // 0: collector.MetricsResponse.collector:type_name -> collector.Collector
-	2,  // 1: collector.Collector.loadaverage:type_name -> collector.LoadAverage
-	3,  // 2: collector.Collector.cpuusage:type_name -> collector.CPUUsage
-	4,  // 3: collector.Collector.diskusage:type_name -> collector.DiskUsage
-	5,  // 4: collector.Collector.filesystemusage:type_name -> collector.FileSystemUsage
-	6,  // 5: collector.Collector.networkprotocol:type_name -> collector.NetworkProtocol
-	7,  // 6: collector.Collector.trafficinfo:type_name -> collector.TrafficInfo
-	9,  // 7: collector.Collector.tcpstates:type_name -> collector.TCPStates
-	8,  // 8: collector.Collector.listeningsocket:type_name -> collector.ListeningSocket
-	0,  // 9: collector.MetricsCollector.CollectMetrics:input_type -> collector.MetricsRequest
-	1,  // 10: collector.MetricsCollector.CollectMetrics:output_type -> collector.MetricsResponse
-	10, // [10:11] is the sub-list for method output_type
-	9,  // [9:10] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+func (x *HostInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInfoRequest) ProtoMessage() {}
+
+func (x *HostInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInfoRequest.ProtoReflect.Descriptor instead.
+func (*HostInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{13}
+}
+
+type HostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	MachineId     string                 `protobuf:"bytes,2,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	KernelRelease string                 `protobuf:"bytes,3,opt,name=kernel_release,json=kernelRelease,proto3" json:"kernel_release,omitempty"`
+	KernelVersion string                 `protobuf:"bytes,4,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	Architecture  string                 `protobuf:"bytes,5,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	OsId          string                 `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsName        string                 `protobuf:"bytes,7,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
+	OsVersion     string                 `protobuf:"bytes,8,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	OsPrettyName  string                 `protobuf:"bytes,9,opt,name=os_pretty_name,json=osPrettyName,proto3" json:"os_pretty_name,omitempty"`
+	BootTime      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=boot_time,json=bootTime,proto3" json:"boot_time,omitempty"`
+	Uptime        *durationpb.Duration   `protobuf:"bytes,11,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	CpuModel      string                 `protobuf:"bytes,12,opt,name=cpu_model,json=cpuModel,proto3" json:"cpu_model,omitempty"`
+	CpuSockets    int64                  `protobuf:"varint,13,opt,name=cpu_sockets,json=cpuSockets,proto3" json:"cpu_sockets,omitempty"`
+	CpuCores      int64                  `protobuf:"varint,14,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	CpuThreads    int64                  `protobuf:"varint,15,opt,name=cpu_threads,json=cpuThreads,proto3" json:"cpu_threads,omitempty"`
+	MemoryTotal   int64                  `protobuf:"varint,16,opt,name=memory_total,json=memoryTotal,proto3" json:"memory_total,omitempty"`
+}
+
+func (x *HostInfo) Reset() {
+	*x = HostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostInfo) ProtoMessage() {}
+
+func (x *HostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostInfo.ProtoReflect.Descriptor instead.
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HostInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HostInfo) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *HostInfo) GetKernelRelease() string {
+	if x != nil {
+		return x.KernelRelease
+	}
+	return ""
+}
+
+func (x *HostInfo) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *HostInfo) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *HostInfo) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *HostInfo) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
+}
+
+func (x *HostInfo) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *HostInfo) GetOsPrettyName() string {
+	if x != nil {
+		return x.OsPrettyName
+	}
+	return ""
+}
+
+func (x *HostInfo) GetBootTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BootTime
+	}
+	return nil
+}
+
+func (x *HostInfo) GetUptime() *durationpb.Duration {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+func (x *HostInfo) GetCpuModel() string {
+	if x != nil {
+		return x.CpuModel
+	}
+	return ""
+}
+
+func (x *HostInfo) GetCpuSockets() int64 {
+	if x != nil {
+		return x.CpuSockets
+	}
+	return 0
+}
+
+func (x *HostInfo) GetCpuCores() int64 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *HostInfo) GetCpuThreads() int64 {
+	if x != nil {
+		return x.CpuThreads
+	}
+	return 0
+}
+
+func (x *HostInfo) GetMemoryTotal() int64 {
+	if x != nil {
+		return x.MemoryTotal
+	}
+	return 0
+}
+
+type LoadAverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OneMinute      float64 `protobuf:"fixed64,1,opt,name=one_minute,json=oneMinute,proto3" json:"one_minute,omitempty"`
+	FiveMinutes    float64 `protobuf:"fixed64,2,opt,name=five_minutes,json=fiveMinutes,proto3" json:"five_minutes,omitempty"`
+	FifteenMinutes float64 `protobuf:"fixed64,3,opt,name=fifteen_minutes,json=fifteenMinutes,proto3" json:"fifteen_minutes,omitempty"`
+}
+
+func (x *LoadAverage) Reset() {
+	*x = LoadAverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadAverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadAverage) ProtoMessage() {}
+
+func (x *LoadAverage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadAverage.ProtoReflect.Descriptor instead.
+func (*LoadAverage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoadAverage) GetOneMinute() float64 {
+	if x != nil {
+		return x.OneMinute
+	}
+	return 0
+}
+
+func (x *LoadAverage) GetFiveMinutes() float64 {
+	if x != nil {
+		return x.FiveMinutes
+	}
+	return 0
+}
+
+func (x *LoadAverage) GetFifteenMinutes() float64 {
+	if x != nil {
+		return x.FifteenMinutes
+	}
+	return 0
+}
+
+// CPUUsage splits the time of all CPUs by mode, in percent of a one second sample. Releases
+// before the metrics exporters sent the cumulative jiffies of /proc/stat in the same fields,
+// clients that took the difference of two responses must now read the values as they are.
+type CPUUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserMode   float64 `protobuf:"fixed64,1,opt,name=user_mode,json=userMode,proto3" json:"user_mode,omitempty"`
+	SystemMode float64 `protobuf:"fixed64,2,opt,name=system_mode,json=systemMode,proto3" json:"system_mode,omitempty"`
+	Idle       float64 `protobuf:"fixed64,3,opt,name=idle,proto3" json:"idle,omitempty"`
+}
+
+func (x *CPUUsage) Reset() {
+	*x = CPUUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CPUUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUUsage) ProtoMessage() {}
+
+func (x *CPUUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUUsage.ProtoReflect.Descriptor instead.
+func (*CPUUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CPUUsage) GetUserMode() float64 {
+	if x != nil {
+		return x.UserMode
+	}
+	return 0
+}
+
+func (x *CPUUsage) GetSystemMode() float64 {
+	if x != nil {
+		return x.SystemMode
+	}
+	return 0
+}
+
+func (x *CPUUsage) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+type DiskUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tps      float64 `protobuf:"fixed64,2,opt,name=tps,proto3" json:"tps,omitempty"`
+	Kbpersec float64 `protobuf:"fixed64,3,opt,name=kbpersec,proto3" json:"kbpersec,omitempty"`
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsage) ProtoMessage() {}
+
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiskUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetTps() float64 {
+	if x != nil {
+		return x.Tps
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetKbpersec() float64 {
+	if x != nil {
+		return x.Kbpersec
+	}
+	return 0
+}
+
+type FileSystemUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileSystem   string  `protobuf:"bytes,1,opt,name=file_system,json=fileSystem,proto3" json:"file_system,omitempty"`
+	Usedmb       float64 `protobuf:"fixed64,2,opt,name=usedmb,proto3" json:"usedmb,omitempty"`
+	UsedPercent  float64 `protobuf:"fixed64,3,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+	UsedInode    float64 `protobuf:"fixed64,4,opt,name=used_inode,json=usedInode,proto3" json:"used_inode,omitempty"`
+	InodePercent float64 `protobuf:"fixed64,5,opt,name=inode_percent,json=inodePercent,proto3" json:"inode_percent,omitempty"`
+	MountPoint   string  `protobuf:"bytes,6,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+}
+
+func (x *FileSystemUsage) Reset() {
+	*x = FileSystemUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileSystemUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileSystemUsage) ProtoMessage() {}
+
+func (x *FileSystemUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileSystemUsage.ProtoReflect.Descriptor instead.
+func (*FileSystemUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileSystemUsage) GetFileSystem() string {
+	if x != nil {
+		return x.FileSystem
+	}
+	return ""
+}
+
+func (x *FileSystemUsage) GetUsedmb() float64 {
+	if x != nil {
+		return x.Usedmb
+	}
+	return 0
+}
+
+func (x *FileSystemUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *FileSystemUsage) GetUsedInode() float64 {
+	if x != nil {
+		return x.UsedInode
+	}
+	return 0
+}
+
+func (x *FileSystemUsage) GetInodePercent() float64 {
+	if x != nil {
+		return x.InodePercent
+	}
+	return 0
+}
+
+func (x *FileSystemUsage) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+type NetworkProtocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol string  `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Bytes    int64   `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Percent  float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *NetworkProtocol) Reset() {
+	*x = NetworkProtocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkProtocol) ProtoMessage() {}
+
+func (x *NetworkProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkProtocol.ProtoReflect.Descriptor instead.
+func (*NetworkProtocol) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NetworkProtocol) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *NetworkProtocol) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *NetworkProtocol) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type TrafficInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sourceip   string  `protobuf:"bytes,1,opt,name=sourceip,proto3" json:"sourceip,omitempty"`
+	SourcePort int64   `protobuf:"varint,2,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	Destip     string  `protobuf:"bytes,3,opt,name=destip,proto3" json:"destip,omitempty"`
+	DestPort   int64   `protobuf:"varint,4,opt,name=dest_port,json=destPort,proto3" json:"dest_port,omitempty"`
+	Protocol   string  `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Bps        float64 `protobuf:"fixed64,6,opt,name=bps,proto3" json:"bps,omitempty"`
+	Bytes      int64   `protobuf:"varint,7,opt,name=Bytes,proto3" json:"Bytes,omitempty"`
+	State      string  `protobuf:"bytes,8,opt,name=State,proto3" json:"State,omitempty"`
+	// Container of the process owning the socket, empty when the owner is not found.
+	Container *ContainerIdentity `protobuf:"bytes,9,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *TrafficInfo) Reset() {
+	*x = TrafficInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficInfo) ProtoMessage() {}
+
+func (x *TrafficInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficInfo.ProtoReflect.Descriptor instead.
+func (*TrafficInfo) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TrafficInfo) GetSourceip() string {
+	if x != nil {
+		return x.Sourceip
+	}
+	return ""
+}
+
+func (x *TrafficInfo) GetSourcePort() int64 {
+	if x != nil {
+		return x.SourcePort
+	}
+	return 0
+}
+
+func (x *TrafficInfo) GetDestip() string {
+	if x != nil {
+		return x.Destip
+	}
+	return ""
+}
+
+func (x *TrafficInfo) GetDestPort() int64 {
+	if x != nil {
+		return x.DestPort
+	}
+	return 0
+}
+
+func (x *TrafficInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TrafficInfo) GetBps() float64 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+func (x *TrafficInfo) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *TrafficInfo) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TrafficInfo) GetContainer() *ContainerIdentity {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type ContainerIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runtime     string `protobuf:"bytes,1,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	PodUid      string `protobuf:"bytes,3,opt,name=pod_uid,json=podUid,proto3" json:"pod_uid,omitempty"`
+	QosClass    string `protobuf:"bytes,4,opt,name=qos_class,json=qosClass,proto3" json:"qos_class,omitempty"`
+	Unit        string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (x *ContainerIdentity) Reset() {
+	*x = ContainerIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerIdentity) ProtoMessage() {}
+
+func (x *ContainerIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerIdentity.ProtoReflect.Descriptor instead.
+func (*ContainerIdentity) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ContainerIdentity) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *ContainerIdentity) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerIdentity) GetPodUid() string {
+	if x != nil {
+		return x.PodUid
+	}
+	return ""
+}
+
+func (x *ContainerIdentity) GetQosClass() string {
+	if x != nil {
+		return x.QosClass
+	}
+	return ""
+}
+
+func (x *ContainerIdentity) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type ListeningSocket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command   string             `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Pid       int64              `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	User      string             `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Protocol  string             `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port      int64              `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Container *ContainerIdentity `protobuf:"bytes,6,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *ListeningSocket) Reset() {
+	*x = ListeningSocket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListeningSocket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListeningSocket) ProtoMessage() {}
+
+func (x *ListeningSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListeningSocket.ProtoReflect.Descriptor instead.
+func (*ListeningSocket) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListeningSocket) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ListeningSocket) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ListeningSocket) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListeningSocket) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ListeningSocket) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ListeningSocket) GetContainer() *ContainerIdentity {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type TCPStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TCPStates) Reset() {
+	*x = TCPStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TCPStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCPStates) ProtoMessage() {}
+
+func (x *TCPStates) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCPStates.ProtoReflect.Descriptor instead.
+func (*TCPStates) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TCPStates) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *TCPStates) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Interrupt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Count       int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	PerSecond   float64 `protobuf:"fixed64,4,opt,name=per_second,json=perSecond,proto3" json:"per_second,omitempty"`
+}
+
+func (x *Interrupt) Reset() {
+	*x = Interrupt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interrupt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interrupt) ProtoMessage() {}
+
+func (x *Interrupt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interrupt.ProtoReflect.Descriptor instead.
+func (*Interrupt) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Interrupt) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Interrupt) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Interrupt) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Interrupt) GetPerSecond() float64 {
+	if x != nil {
+		return x.PerSecond
+	}
+	return 0
+}
+
+type KernelActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextSwitches float64      `protobuf:"fixed64,1,opt,name=context_switches,json=contextSwitches,proto3" json:"context_switches,omitempty"`
+	Interrupts      float64      `protobuf:"fixed64,2,opt,name=interrupts,proto3" json:"interrupts,omitempty"`
+	Forks           float64      `protobuf:"fixed64,3,opt,name=forks,proto3" json:"forks,omitempty"`
+	Softirqs        float64      `protobuf:"fixed64,4,opt,name=softirqs,proto3" json:"softirqs,omitempty"`
+	ProcsRunning    int64        `protobuf:"varint,5,opt,name=procs_running,json=procsRunning,proto3" json:"procs_running,omitempty"`
+	ProcsBlocked    int64        `protobuf:"varint,6,opt,name=procs_blocked,json=procsBlocked,proto3" json:"procs_blocked,omitempty"`
+	BootTime        int64        `protobuf:"varint,7,opt,name=boot_time,json=bootTime,proto3" json:"boot_time,omitempty"`
+	Irqs            []*Interrupt `protobuf:"bytes,8,rep,name=irqs,proto3" json:"irqs,omitempty"`
+	Softirq         []*Interrupt `protobuf:"bytes,9,rep,name=softirq,proto3" json:"softirq,omitempty"`
+}
+
+func (x *KernelActivity) Reset() {
+	*x = KernelActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KernelActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KernelActivity) ProtoMessage() {}
+
+func (x *KernelActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KernelActivity.ProtoReflect.Descriptor instead.
+func (*KernelActivity) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *KernelActivity) GetContextSwitches() float64 {
+	if x != nil {
+		return x.ContextSwitches
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetInterrupts() float64 {
+	if x != nil {
+		return x.Interrupts
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetForks() float64 {
+	if x != nil {
+		return x.Forks
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetSoftirqs() float64 {
+	if x != nil {
+		return x.Softirqs
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetProcsRunning() int64 {
+	if x != nil {
+		return x.ProcsRunning
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetProcsBlocked() int64 {
+	if x != nil {
+		return x.ProcsBlocked
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetBootTime() int64 {
+	if x != nil {
+		return x.BootTime
+	}
+	return 0
+}
+
+func (x *KernelActivity) GetIrqs() []*Interrupt {
+	if x != nil {
+		return x.Irqs
+	}
+	return nil
+}
+
+func (x *KernelActivity) GetSoftirq() []*Interrupt {
+	if x != nil {
+		return x.Softirq
+	}
+	return nil
+}
+
+type ProcessUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid              int64              `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid             int64              `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Command          string             `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Cmdline          string             `protobuf:"bytes,4,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
+	User             string             `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	State            string             `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	CpuPercent       float64            `protobuf:"fixed64,7,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	RssBytes         int64              `protobuf:"varint,8,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	SwapBytes        int64              `protobuf:"varint,9,opt,name=swap_bytes,json=swapBytes,proto3" json:"swap_bytes,omitempty"`
+	ReadBytesPerSec  float64            `protobuf:"fixed64,10,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec float64            `protobuf:"fixed64,11,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	Threads          int64              `protobuf:"varint,12,opt,name=threads,proto3" json:"threads,omitempty"`
+	OpenFds          int64              `protobuf:"varint,13,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+	Container        *ContainerIdentity `protobuf:"bytes,14,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *ProcessUsage) Reset() {
+	*x = ProcessUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessUsage) ProtoMessage() {}
+
+func (x *ProcessUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessUsage.ProtoReflect.Descriptor instead.
+func (*ProcessUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ProcessUsage) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetPpid() int64 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ProcessUsage) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+func (x *ProcessUsage) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ProcessUsage) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProcessUsage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetRssBytes() int64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetSwapBytes() int64 {
+	if x != nil {
+		return x.SwapBytes
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetReadBytesPerSec() float64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetWriteBytesPerSec() float64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetThreads() int64 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetOpenFds() int64 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+func (x *ProcessUsage) GetContainer() *ContainerIdentity {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type WatchedProcess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Alive            bool    `protobuf:"varint,2,opt,name=alive,proto3" json:"alive,omitempty"`
+	Pid              int64   `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	Pids             []int64 `protobuf:"varint,4,rep,packed,name=pids,proto3" json:"pids,omitempty"`
+	Restarts         int64   `protobuf:"varint,5,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	CpuPercent       float64 `protobuf:"fixed64,6,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	RssBytes         int64   `protobuf:"varint,7,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	SwapBytes        int64   `protobuf:"varint,8,opt,name=swap_bytes,json=swapBytes,proto3" json:"swap_bytes,omitempty"`
+	ReadBytesPerSec  float64 `protobuf:"fixed64,9,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec float64 `protobuf:"fixed64,10,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	Threads          int64   `protobuf:"varint,11,opt,name=threads,proto3" json:"threads,omitempty"`
+	OpenFds          int64   `protobuf:"varint,12,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+}
+
+func (x *WatchedProcess) Reset() {
+	*x = WatchedProcess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedProcess) ProtoMessage() {}
+
+func (x *WatchedProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedProcess.ProtoReflect.Descriptor instead.
+func (*WatchedProcess) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchedProcess) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchedProcess) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+func (x *WatchedProcess) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetPids() []int64 {
+	if x != nil {
+		return x.Pids
+	}
+	return nil
+}
+
+func (x *WatchedProcess) GetRestarts() int64 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetRssBytes() int64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetSwapBytes() int64 {
+	if x != nil {
+		return x.SwapBytes
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetReadBytesPerSec() float64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetWriteBytesPerSec() float64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetThreads() int64 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *WatchedProcess) GetOpenFds() int64 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+type ProcessEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeHistory bool               `protobuf:"varint,1,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+	Types          []ProcessEventType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=collector.ProcessEventType" json:"types,omitempty"`
+}
+
+func (x *ProcessEventsRequest) Reset() {
+	*x = ProcessEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessEventsRequest) ProtoMessage() {}
+
+func (x *ProcessEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessEventsRequest.ProtoReflect.Descriptor instead.
+func (*ProcessEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessEventsRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+func (x *ProcessEventsRequest) GetTypes() []ProcessEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type ProcessEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type       ProcessEventType       `protobuf:"varint,2,opt,name=type,proto3,enum=collector.ProcessEventType" json:"type,omitempty"`
+	Pid        int64                  `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid       int64                  `protobuf:"varint,4,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Command    string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	ExitCode   int64                  `protobuf:"varint,6,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	ExitSignal int64                  `protobuf:"varint,7,opt,name=exit_signal,json=exitSignal,proto3" json:"exit_signal,omitempty"`
+}
+
+func (x *ProcessEvent) Reset() {
+	*x = ProcessEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessEvent) ProtoMessage() {}
+
+func (x *ProcessEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessEvent.ProtoReflect.Descriptor instead.
+func (*ProcessEvent) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ProcessEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ProcessEvent) GetType() ProcessEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ProcessEventType_PROCESS_EVENT_UNKNOWN
+}
+
+func (x *ProcessEvent) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessEvent) GetPpid() int64 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *ProcessEvent) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ProcessEvent) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ProcessEvent) GetExitSignal() int64 {
+	if x != nil {
+		return x.ExitSignal
+	}
+	return 0
+}
+
+type ProcessEventCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forks          int64   `protobuf:"varint,1,opt,name=forks,proto3" json:"forks,omitempty"`
+	Execs          int64   `protobuf:"varint,2,opt,name=execs,proto3" json:"execs,omitempty"`
+	Exits          int64   `protobuf:"varint,3,opt,name=exits,proto3" json:"exits,omitempty"`
+	ForksPerSecond float64 `protobuf:"fixed64,4,opt,name=forks_per_second,json=forksPerSecond,proto3" json:"forks_per_second,omitempty"`
+	ExecsPerSecond float64 `protobuf:"fixed64,5,opt,name=execs_per_second,json=execsPerSecond,proto3" json:"execs_per_second,omitempty"`
+	ExitsPerSecond float64 `protobuf:"fixed64,6,opt,name=exits_per_second,json=exitsPerSecond,proto3" json:"exits_per_second,omitempty"`
+	// Events lost within the window, to slow subscribers or to netlink buffer overruns.
+	Dropped int64 `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *ProcessEventCounts) Reset() {
+	*x = ProcessEventCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessEventCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessEventCounts) ProtoMessage() {}
+
+func (x *ProcessEventCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessEventCounts.ProtoReflect.Descriptor instead.
+func (*ProcessEventCounts) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProcessEventCounts) GetForks() int64 {
+	if x != nil {
+		return x.Forks
+	}
+	return 0
+}
+
+func (x *ProcessEventCounts) GetExecs() int64 {
+	if x != nil {
+		return x.Execs
+	}
+	return 0
+}
+
+func (x *ProcessEventCounts) GetExits() int64 {
+	if x != nil {
+		return x.Exits
+	}
+	return 0
+}
+
+func (x *ProcessEventCounts) GetForksPerSecond() float64 {
+	if x != nil {
+		return x.ForksPerSecond
+	}
+	return 0
+}
+
+func (x *ProcessEventCounts) GetExecsPerSecond() float64 {
+	if x != nil {
+		return x.ExecsPerSecond
+	}
+	return 0
+}
+
+func (x *ProcessEventCounts) GetExitsPerSecond() float64 {
+	if x != nil {
+		return x.ExitsPerSecond
+	}
+	return 0
+}
+
+func (x *ProcessEventCounts) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type ZombieProcess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid           int64  `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Ppid          int64  `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Command       string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	ParentCommand string `protobuf:"bytes,4,opt,name=parent_command,json=parentCommand,proto3" json:"parent_command,omitempty"`
+}
+
+func (x *ZombieProcess) Reset() {
+	*x = ZombieProcess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ZombieProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZombieProcess) ProtoMessage() {}
+
+func (x *ZombieProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZombieProcess.ProtoReflect.Descriptor instead.
+func (*ZombieProcess) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ZombieProcess) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ZombieProcess) GetPpid() int64 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *ZombieProcess) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *ZombieProcess) GetParentCommand() string {
+	if x != nil {
+		return x.ParentCommand
+	}
+	return ""
+}
+
+type ProcessStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total               int64            `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Running             int64            `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Sleeping            int64            `protobuf:"varint,3,opt,name=sleeping,proto3" json:"sleeping,omitempty"`
+	DiskSleep           int64            `protobuf:"varint,4,opt,name=disk_sleep,json=diskSleep,proto3" json:"disk_sleep,omitempty"`
+	Stopped             int64            `protobuf:"varint,5,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	Zombie              int64            `protobuf:"varint,6,opt,name=zombie,proto3" json:"zombie,omitempty"`
+	Idle                int64            `protobuf:"varint,7,opt,name=idle,proto3" json:"idle,omitempty"`
+	Threads             int64            `protobuf:"varint,8,opt,name=threads,proto3" json:"threads,omitempty"`
+	PidMax              int64            `protobuf:"varint,9,opt,name=pid_max,json=pidMax,proto3" json:"pid_max,omitempty"`
+	ThreadsMax          int64            `protobuf:"varint,10,opt,name=threads_max,json=threadsMax,proto3" json:"threads_max,omitempty"`
+	PidUsagePercent     float64          `protobuf:"fixed64,11,opt,name=pid_usage_percent,json=pidUsagePercent,proto3" json:"pid_usage_percent,omitempty"`
+	ThreadsUsagePercent float64          `protobuf:"fixed64,12,opt,name=threads_usage_percent,json=threadsUsagePercent,proto3" json:"threads_usage_percent,omitempty"`
+	Zombies             []*ZombieProcess `protobuf:"bytes,13,rep,name=zombies,proto3" json:"zombies,omitempty"`
+}
+
+func (x *ProcessStates) Reset() {
+	*x = ProcessStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStates) ProtoMessage() {}
+
+func (x *ProcessStates) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStates.ProtoReflect.Descriptor instead.
+func (*ProcessStates) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProcessStates) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetRunning() int64 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetSleeping() int64 {
+	if x != nil {
+		return x.Sleeping
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetDiskSleep() int64 {
+	if x != nil {
+		return x.DiskSleep
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetStopped() int64 {
+	if x != nil {
+		return x.Stopped
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetZombie() int64 {
+	if x != nil {
+		return x.Zombie
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetIdle() int64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetThreads() int64 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetPidMax() int64 {
+	if x != nil {
+		return x.PidMax
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetThreadsMax() int64 {
+	if x != nil {
+		return x.ThreadsMax
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetPidUsagePercent() float64 {
+	if x != nil {
+		return x.PidUsagePercent
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetThreadsUsagePercent() float64 {
+	if x != nil {
+		return x.ThreadsUsagePercent
+	}
+	return 0
+}
+
+func (x *ProcessStates) GetZombies() []*ZombieProcess {
+	if x != nil {
+		return x.Zombies
+	}
+	return nil
+}
+
+type Pressure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource   string  `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	SomeAvg10  float64 `protobuf:"fixed64,2,opt,name=some_avg10,json=someAvg10,proto3" json:"some_avg10,omitempty"`
+	SomeAvg60  float64 `protobuf:"fixed64,3,opt,name=some_avg60,json=someAvg60,proto3" json:"some_avg60,omitempty"`
+	SomeAvg300 float64 `protobuf:"fixed64,4,opt,name=some_avg300,json=someAvg300,proto3" json:"some_avg300,omitempty"`
+	FullAvg10  float64 `protobuf:"fixed64,5,opt,name=full_avg10,json=fullAvg10,proto3" json:"full_avg10,omitempty"`
+	FullAvg60  float64 `protobuf:"fixed64,6,opt,name=full_avg60,json=fullAvg60,proto3" json:"full_avg60,omitempty"`
+	FullAvg300 float64 `protobuf:"fixed64,7,opt,name=full_avg300,json=fullAvg300,proto3" json:"full_avg300,omitempty"`
+}
+
+func (x *Pressure) Reset() {
+	*x = Pressure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pressure) ProtoMessage() {}
+
+func (x *Pressure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pressure.ProtoReflect.Descriptor instead.
+func (*Pressure) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Pressure) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *Pressure) GetSomeAvg10() float64 {
+	if x != nil {
+		return x.SomeAvg10
+	}
+	return 0
+}
+
+func (x *Pressure) GetSomeAvg60() float64 {
+	if x != nil {
+		return x.SomeAvg60
+	}
+	return 0
+}
+
+func (x *Pressure) GetSomeAvg300() float64 {
+	if x != nil {
+		return x.SomeAvg300
+	}
+	return 0
+}
+
+func (x *Pressure) GetFullAvg10() float64 {
+	if x != nil {
+		return x.FullAvg10
+	}
+	return 0
+}
+
+func (x *Pressure) GetFullAvg60() float64 {
+	if x != nil {
+		return x.FullAvg60
+	}
+	return 0
+}
+
+func (x *Pressure) GetFullAvg300() float64 {
+	if x != nil {
+		return x.FullAvg300
+	}
+	return 0
+}
+
+type CgroupUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path             string             `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	CpuPercent       float64            `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	CpuUserPercent   float64            `protobuf:"fixed64,3,opt,name=cpu_user_percent,json=cpuUserPercent,proto3" json:"cpu_user_percent,omitempty"`
+	CpuSystemPercent float64            `protobuf:"fixed64,4,opt,name=cpu_system_percent,json=cpuSystemPercent,proto3" json:"cpu_system_percent,omitempty"`
+	ThrottledPercent float64            `protobuf:"fixed64,5,opt,name=throttled_percent,json=throttledPercent,proto3" json:"throttled_percent,omitempty"`
+	MemoryBytes      int64              `protobuf:"varint,6,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	MemoryAnon       int64              `protobuf:"varint,7,opt,name=memory_anon,json=memoryAnon,proto3" json:"memory_anon,omitempty"`
+	MemoryFile       int64              `protobuf:"varint,8,opt,name=memory_file,json=memoryFile,proto3" json:"memory_file,omitempty"`
+	MemoryKernel     int64              `protobuf:"varint,9,opt,name=memory_kernel,json=memoryKernel,proto3" json:"memory_kernel,omitempty"`
+	MemoryShmem      int64              `protobuf:"varint,10,opt,name=memory_shmem,json=memoryShmem,proto3" json:"memory_shmem,omitempty"`
+	ReadBytesPerSec  float64            `protobuf:"fixed64,11,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec float64            `protobuf:"fixed64,12,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	ReadIops         float64            `protobuf:"fixed64,13,opt,name=read_iops,json=readIops,proto3" json:"read_iops,omitempty"`
+	WriteIops        float64            `protobuf:"fixed64,14,opt,name=write_iops,json=writeIops,proto3" json:"write_iops,omitempty"`
+	Pids             int64              `protobuf:"varint,15,opt,name=pids,proto3" json:"pids,omitempty"`
+	Pressure         []*Pressure        `protobuf:"bytes,16,rep,name=pressure,proto3" json:"pressure,omitempty"`
+	Container        *ContainerIdentity `protobuf:"bytes,17,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *CgroupUsage) Reset() {
+	*x = CgroupUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupUsage) ProtoMessage() {}
+
+func (x *CgroupUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupUsage.ProtoReflect.Descriptor instead.
+func (*CgroupUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CgroupUsage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CgroupUsage) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetCpuUserPercent() float64 {
+	if x != nil {
+		return x.CpuUserPercent
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetCpuSystemPercent() float64 {
+	if x != nil {
+		return x.CpuSystemPercent
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetThrottledPercent() float64 {
+	if x != nil {
+		return x.ThrottledPercent
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryAnon() int64 {
+	if x != nil {
+		return x.MemoryAnon
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryFile() int64 {
+	if x != nil {
+		return x.MemoryFile
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryKernel() int64 {
+	if x != nil {
+		return x.MemoryKernel
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetMemoryShmem() int64 {
+	if x != nil {
+		return x.MemoryShmem
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetReadBytesPerSec() float64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetWriteBytesPerSec() float64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetReadIops() float64 {
+	if x != nil {
+		return x.ReadIops
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetWriteIops() float64 {
+	if x != nil {
+		return x.WriteIops
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetPids() int64 {
+	if x != nil {
+		return x.Pids
+	}
+	return 0
+}
+
+func (x *CgroupUsage) GetPressure() []*Pressure {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+func (x *CgroupUsage) GetContainer() *ContainerIdentity {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type NetworkNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inode           int64              `protobuf:"varint,1,opt,name=inode,proto3" json:"inode,omitempty"`
+	Pid             int64              `protobuf:"varint,2,opt,name=pid,proto3" json:"pid,omitempty"`
+	Host            bool               `protobuf:"varint,3,opt,name=host,proto3" json:"host,omitempty"`
+	Container       *ContainerIdentity `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	Connections     int64              `protobuf:"varint,5,opt,name=connections,proto3" json:"connections,omitempty"`
+	Trafficinfo     []*TrafficInfo     `protobuf:"bytes,6,rep,name=trafficinfo,proto3" json:"trafficinfo,omitempty"`
+	Listeningsocket []*ListeningSocket `protobuf:"bytes,7,rep,name=listeningsocket,proto3" json:"listeningsocket,omitempty"`
+	Tcpstates       []*TCPStates       `protobuf:"bytes,8,rep,name=tcpstates,proto3" json:"tcpstates,omitempty"`
+}
+
+func (x *NetworkNamespace) Reset() {
+	*x = NetworkNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkNamespace) ProtoMessage() {}
+
+func (x *NetworkNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkNamespace.ProtoReflect.Descriptor instead.
+func (*NetworkNamespace) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NetworkNamespace) GetInode() int64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *NetworkNamespace) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *NetworkNamespace) GetHost() bool {
+	if x != nil {
+		return x.Host
+	}
+	return false
+}
+
+func (x *NetworkNamespace) GetContainer() *ContainerIdentity {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *NetworkNamespace) GetConnections() int64 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *NetworkNamespace) GetTrafficinfo() []*TrafficInfo {
+	if x != nil {
+		return x.Trafficinfo
+	}
+	return nil
+}
+
+func (x *NetworkNamespace) GetListeningsocket() []*ListeningSocket {
+	if x != nil {
+		return x.Listeningsocket
+	}
+	return nil
+}
+
+func (x *NetworkNamespace) GetTcpstates() []*TCPStates {
+	if x != nil {
+		return x.Tcpstates
+	}
+	return nil
+}
+
+type FileDescriptorProcess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid          int64              `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Command      string             `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	OpenFds      int64              `protobuf:"varint,3,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+	Limit        int64              `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	UsagePercent float64            `protobuf:"fixed64,5,opt,name=usage_percent,json=usagePercent,proto3" json:"usage_percent,omitempty"`
+	Container    *ContainerIdentity `protobuf:"bytes,6,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *FileDescriptorProcess) Reset() {
+	*x = FileDescriptorProcess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDescriptorProcess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDescriptorProcess) ProtoMessage() {}
+
+func (x *FileDescriptorProcess) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDescriptorProcess.ProtoReflect.Descriptor instead.
+func (*FileDescriptorProcess) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FileDescriptorProcess) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *FileDescriptorProcess) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *FileDescriptorProcess) GetOpenFds() int64 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+func (x *FileDescriptorProcess) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FileDescriptorProcess) GetUsagePercent() float64 {
+	if x != nil {
+		return x.UsagePercent
+	}
+	return 0
+}
+
+func (x *FileDescriptorProcess) GetContainer() *ContainerIdentity {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type FileDescriptorUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allocated    int64                    `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Max          int64                    `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	UsagePercent float64                  `protobuf:"fixed64,3,opt,name=usage_percent,json=usagePercent,proto3" json:"usage_percent,omitempty"`
+	Inodes       int64                    `protobuf:"varint,4,opt,name=inodes,proto3" json:"inodes,omitempty"`
+	FreeInodes   int64                    `protobuf:"varint,5,opt,name=free_inodes,json=freeInodes,proto3" json:"free_inodes,omitempty"`
+	PidMax       int64                    `protobuf:"varint,6,opt,name=pid_max,json=pidMax,proto3" json:"pid_max,omitempty"`
+	Processes    []*FileDescriptorProcess `protobuf:"bytes,7,rep,name=processes,proto3" json:"processes,omitempty"`
+}
+
+func (x *FileDescriptorUsage) Reset() {
+	*x = FileDescriptorUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileDescriptorUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileDescriptorUsage) ProtoMessage() {}
+
+func (x *FileDescriptorUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileDescriptorUsage.ProtoReflect.Descriptor instead.
+func (*FileDescriptorUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FileDescriptorUsage) GetAllocated() int64 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+func (x *FileDescriptorUsage) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *FileDescriptorUsage) GetUsagePercent() float64 {
+	if x != nil {
+		return x.UsagePercent
+	}
+	return 0
+}
+
+func (x *FileDescriptorUsage) GetInodes() int64 {
+	if x != nil {
+		return x.Inodes
+	}
+	return 0
+}
+
+func (x *FileDescriptorUsage) GetFreeInodes() int64 {
+	if x != nil {
+		return x.FreeInodes
+	}
+	return 0
+}
+
+func (x *FileDescriptorUsage) GetPidMax() int64 {
+	if x != nil {
+		return x.PidMax
+	}
+	return 0
+}
+
+func (x *FileDescriptorUsage) GetProcesses() []*FileDescriptorProcess {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type ThermalZone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Zone    string  `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Type    string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Celsius float64 `protobuf:"fixed64,3,opt,name=celsius,proto3" json:"celsius,omitempty"`
+}
+
+func (x *ThermalZone) Reset() {
+	*x = ThermalZone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThermalZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThermalZone) ProtoMessage() {}
+
+func (x *ThermalZone) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThermalZone.ProtoReflect.Descriptor instead.
+func (*ThermalZone) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ThermalZone) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ThermalZone) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ThermalZone) GetCelsius() float64 {
+	if x != nil {
+		return x.Celsius
+	}
+	return 0
+}
+
+type HwmonSensor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chip   string  `protobuf:"bytes,1,opt,name=chip,proto3" json:"chip,omitempty"`
+	Sensor string  `protobuf:"bytes,2,opt,name=sensor,proto3" json:"sensor,omitempty"`
+	Label  string  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Kind   string  `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value  float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	// hwmonN directory of the chip, chips of the same driver share a name.
+	Device string `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *HwmonSensor) Reset() {
+	*x = HwmonSensor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HwmonSensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HwmonSensor) ProtoMessage() {}
+
+func (x *HwmonSensor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HwmonSensor.ProtoReflect.Descriptor instead.
+func (*HwmonSensor) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HwmonSensor) GetChip() string {
+	if x != nil {
+		return x.Chip
+	}
+	return ""
+}
+
+func (x *HwmonSensor) GetSensor() string {
+	if x != nil {
+		return x.Sensor
+	}
+	return ""
+}
+
+func (x *HwmonSensor) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *HwmonSensor) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *HwmonSensor) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *HwmonSensor) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type CPUFrequency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cpu              string  `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	CurrentMhz       float64 `protobuf:"fixed64,2,opt,name=current_mhz,json=currentMhz,proto3" json:"current_mhz,omitempty"`
+	MinMhz           float64 `protobuf:"fixed64,3,opt,name=min_mhz,json=minMhz,proto3" json:"min_mhz,omitempty"`
+	MaxMhz           float64 `protobuf:"fixed64,4,opt,name=max_mhz,json=maxMhz,proto3" json:"max_mhz,omitempty"`
+	CoreThrottles    int64   `protobuf:"varint,5,opt,name=core_throttles,json=coreThrottles,proto3" json:"core_throttles,omitempty"`
+	PackageThrottles int64   `protobuf:"varint,6,opt,name=package_throttles,json=packageThrottles,proto3" json:"package_throttles,omitempty"`
+}
+
+func (x *CPUFrequency) Reset() {
+	*x = CPUFrequency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CPUFrequency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUFrequency) ProtoMessage() {}
+
+func (x *CPUFrequency) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUFrequency.ProtoReflect.Descriptor instead.
+func (*CPUFrequency) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CPUFrequency) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *CPUFrequency) GetCurrentMhz() float64 {
+	if x != nil {
+		return x.CurrentMhz
+	}
+	return 0
+}
+
+func (x *CPUFrequency) GetMinMhz() float64 {
+	if x != nil {
+		return x.MinMhz
+	}
+	return 0
+}
+
+func (x *CPUFrequency) GetMaxMhz() float64 {
+	if x != nil {
+		return x.MaxMhz
+	}
+	return 0
+}
+
+func (x *CPUFrequency) GetCoreThrottles() int64 {
+	if x != nil {
+		return x.CoreThrottles
+	}
+	return 0
+}
+
+func (x *CPUFrequency) GetPackageThrottles() int64 {
+	if x != nil {
+		return x.PackageThrottles
+	}
+	return 0
+}
+
+type Sensors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThermalZones []*ThermalZone  `protobuf:"bytes,1,rep,name=thermal_zones,json=thermalZones,proto3" json:"thermal_zones,omitempty"`
+	Hwmon        []*HwmonSensor  `protobuf:"bytes,2,rep,name=hwmon,proto3" json:"hwmon,omitempty"`
+	CpuFrequency []*CPUFrequency `protobuf:"bytes,3,rep,name=cpu_frequency,json=cpuFrequency,proto3" json:"cpu_frequency,omitempty"`
+}
+
+func (x *Sensors) Reset() {
+	*x = Sensors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sensors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sensors) ProtoMessage() {}
+
+func (x *Sensors) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sensors.ProtoReflect.Descriptor instead.
+func (*Sensors) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Sensors) GetThermalZones() []*ThermalZone {
+	if x != nil {
+		return x.ThermalZones
+	}
+	return nil
+}
+
+func (x *Sensors) GetHwmon() []*HwmonSensor {
+	if x != nil {
+		return x.Hwmon
+	}
+	return nil
+}
+
+func (x *Sensors) GetCpuFrequency() []*CPUFrequency {
+	if x != nil {
+		return x.CpuFrequency
+	}
+	return nil
+}
+
+// StatsDMetric is one name and tag set sent to the StatsD listener, aggregated over its flush
+// interval. type is counter, gauge, timer, histogram, distribution or set.
+type StatsDMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Tags  []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Value float64  `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	Rate  float64  `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	Count uint64   `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Min   float64  `protobuf:"fixed64,7,opt,name=min,proto3" json:"min,omitempty"`
+	Max   float64  `protobuf:"fixed64,8,opt,name=max,proto3" json:"max,omitempty"`
+	Mean  float64  `protobuf:"fixed64,9,opt,name=mean,proto3" json:"mean,omitempty"`
+	Sum   float64  `protobuf:"fixed64,10,opt,name=sum,proto3" json:"sum,omitempty"`
+	P50   float64  `protobuf:"fixed64,11,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90   float64  `protobuf:"fixed64,12,opt,name=p90,proto3" json:"p90,omitempty"`
+	P95   float64  `protobuf:"fixed64,13,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99   float64  `protobuf:"fixed64,14,opt,name=p99,proto3" json:"p99,omitempty"`
+}
+
+func (x *StatsDMetric) Reset() {
+	*x = StatsDMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsDMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsDMetric) ProtoMessage() {}
+
+func (x *StatsDMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsDMetric.ProtoReflect.Descriptor instead.
+func (*StatsDMetric) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StatsDMetric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsDMetric) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StatsDMetric) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *StatsDMetric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *StatsDMetric) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+type StatsD struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []*StatsDMetric `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Dropped uint64          `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *StatsD) Reset() {
+	*x = StatsD{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsD) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsD) ProtoMessage() {}
+
+func (x *StatsD) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsD.ProtoReflect.Descriptor instead.
+func (*StatsD) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StatsD) GetMetrics() []*StatsDMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *StatsD) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type MemoryUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalBytes     uint64  `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	UsedBytes      uint64  `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	FreeBytes      uint64  `protobuf:"varint,3,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	AvailableBytes uint64  `protobuf:"varint,4,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	BuffersBytes   uint64  `protobuf:"varint,5,opt,name=buffers_bytes,json=buffersBytes,proto3" json:"buffers_bytes,omitempty"`
+	CachedBytes    uint64  `protobuf:"varint,6,opt,name=cached_bytes,json=cachedBytes,proto3" json:"cached_bytes,omitempty"`
+	SwapTotalBytes uint64  `protobuf:"varint,7,opt,name=swap_total_bytes,json=swapTotalBytes,proto3" json:"swap_total_bytes,omitempty"`
+	SwapUsedBytes  uint64  `protobuf:"varint,8,opt,name=swap_used_bytes,json=swapUsedBytes,proto3" json:"swap_used_bytes,omitempty"`
+	UsedPercent    float64 `protobuf:"fixed64,9,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+}
+
+func (x *MemoryUsage) Reset() {
+	*x = MemoryUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoryUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUsage) ProtoMessage() {}
+
+func (x *MemoryUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUsage.ProtoReflect.Descriptor instead.
+func (*MemoryUsage) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MemoryUsage) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetBuffersBytes() uint64 {
+	if x != nil {
+		return x.BuffersBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetCachedBytes() uint64 {
+	if x != nil {
+		return x.CachedBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetSwapTotalBytes() uint64 {
+	if x != nil {
+		return x.SwapTotalBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetSwapUsedBytes() uint64 {
+	if x != nil {
+		return x.SwapUsedBytes
+	}
+	return 0
+}
+
+func (x *MemoryUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+// NetworkInterface holds the counters of an interface since boot.
+type NetworkInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxBytes   uint64 `protobuf:"varint,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	RxPackets uint64 `protobuf:"varint,3,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	RxErrors  uint64 `protobuf:"varint,4,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	RxDropped uint64 `protobuf:"varint,5,opt,name=rx_dropped,json=rxDropped,proto3" json:"rx_dropped,omitempty"`
+	TxBytes   uint64 `protobuf:"varint,6,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	TxPackets uint64 `protobuf:"varint,7,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	TxErrors  uint64 `protobuf:"varint,8,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	TxDropped uint64 `protobuf:"varint,9,opt,name=tx_dropped,json=txDropped,proto3" json:"tx_dropped,omitempty"`
+}
+
+func (x *NetworkInterface) Reset() {
+	*x = NetworkInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterface) ProtoMessage() {}
+
+func (x *NetworkInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInterface.ProtoReflect.Descriptor instead.
+func (*NetworkInterface) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NetworkInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInterface) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetRxDropped() uint64 {
+	if x != nil {
+		return x.RxDropped
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxErrors() uint64 {
+	if x != nil {
+		return x.TxErrors
+	}
+	return 0
+}
+
+func (x *NetworkInterface) GetTxDropped() uint64 {
+	if x != nil {
+		return x.TxDropped
+	}
+	return 0
+}
+
+type Collector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loadaverage      *LoadAverage         `protobuf:"bytes,1,opt,name=loadaverage,proto3" json:"loadaverage,omitempty"`
+	Cpuusage         *CPUUsage            `protobuf:"bytes,2,opt,name=cpuusage,proto3" json:"cpuusage,omitempty"`
+	Diskusage        []*DiskUsage         `protobuf:"bytes,3,rep,name=diskusage,proto3" json:"diskusage,omitempty"`
+	Filesystemusage  []*FileSystemUsage   `protobuf:"bytes,4,rep,name=filesystemusage,proto3" json:"filesystemusage,omitempty"`
+	Networkprotocol  []*NetworkProtocol   `protobuf:"bytes,5,rep,name=networkprotocol,proto3" json:"networkprotocol,omitempty"`
+	Trafficinfo      []*TrafficInfo       `protobuf:"bytes,6,rep,name=trafficinfo,proto3" json:"trafficinfo,omitempty"`
+	Tcpstates        []*TCPStates         `protobuf:"bytes,7,rep,name=tcpstates,proto3" json:"tcpstates,omitempty"`
+	Listeningsocket  []*ListeningSocket   `protobuf:"bytes,8,rep,name=listeningsocket,proto3" json:"listeningsocket,omitempty"`
+	Kernelactivity   *KernelActivity      `protobuf:"bytes,9,opt,name=kernelactivity,proto3" json:"kernelactivity,omitempty"`
+	Processusage     []*ProcessUsage      `protobuf:"bytes,10,rep,name=processusage,proto3" json:"processusage,omitempty"`
+	Watchedprocess   []*WatchedProcess    `protobuf:"bytes,11,rep,name=watchedprocess,proto3" json:"watchedprocess,omitempty"`
+	Processevents    *ProcessEventCounts  `protobuf:"bytes,12,opt,name=processevents,proto3" json:"processevents,omitempty"`
+	Processstates    *ProcessStates       `protobuf:"bytes,13,opt,name=processstates,proto3" json:"processstates,omitempty"`
+	Cgroupusage      []*CgroupUsage       `protobuf:"bytes,14,rep,name=cgroupusage,proto3" json:"cgroupusage,omitempty"`
+	Networknamespace []*NetworkNamespace  `protobuf:"bytes,15,rep,name=networknamespace,proto3" json:"networknamespace,omitempty"`
+	Filedescriptors  *FileDescriptorUsage `protobuf:"bytes,16,opt,name=filedescriptors,proto3" json:"filedescriptors,omitempty"`
+	Sensors          *Sensors             `protobuf:"bytes,17,opt,name=sensors,proto3" json:"sensors,omitempty"`
+	Statsd           *StatsD              `protobuf:"bytes,18,opt,name=statsd,proto3" json:"statsd,omitempty"`
+	Memory           *MemoryUsage         `protobuf:"bytes,19,opt,name=memory,proto3" json:"memory,omitempty"`
+	Networkinterface []*NetworkInterface  `protobuf:"bytes,20,rep,name=networkinterface,proto3" json:"networkinterface,omitempty"`
+}
+
+func (x *Collector) Reset() {
+	*x = Collector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_pb_metrics_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collector) ProtoMessage() {}
+
+func (x *Collector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_pb_metrics_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collector.ProtoReflect.Descriptor instead.
+func (*Collector) Descriptor() ([]byte, []int) {
+	return file_api_pb_metrics_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Collector) GetLoadaverage() *LoadAverage {
+	if x != nil {
+		return x.Loadaverage
+	}
+	return nil
+}
+
+func (x *Collector) GetCpuusage() *CPUUsage {
+	if x != nil {
+		return x.Cpuusage
+	}
+	return nil
+}
+
+func (x *Collector) GetDiskusage() []*DiskUsage {
+	if x != nil {
+		return x.Diskusage
+	}
+	return nil
+}
+
+func (x *Collector) GetFilesystemusage() []*FileSystemUsage {
+	if x != nil {
+		return x.Filesystemusage
+	}
+	return nil
+}
+
+func (x *Collector) GetNetworkprotocol() []*NetworkProtocol {
+	if x != nil {
+		return x.Networkprotocol
+	}
+	return nil
+}
+
+func (x *Collector) GetTrafficinfo() []*TrafficInfo {
+	if x != nil {
+		return x.Trafficinfo
+	}
+	return nil
+}
+
+func (x *Collector) GetTcpstates() []*TCPStates {
+	if x != nil {
+		return x.Tcpstates
+	}
+	return nil
+}
+
+func (x *Collector) GetListeningsocket() []*ListeningSocket {
+	if x != nil {
+		return x.Listeningsocket
+	}
+	return nil
+}
+
+func (x *Collector) GetKernelactivity() *KernelActivity {
+	if x != nil {
+		return x.Kernelactivity
+	}
+	return nil
+}
+
+func (x *Collector) GetProcessusage() []*ProcessUsage {
+	if x != nil {
+		return x.Processusage
+	}
+	return nil
+}
+
+func (x *Collector) GetWatchedprocess() []*WatchedProcess {
+	if x != nil {
+		return x.Watchedprocess
+	}
+	return nil
+}
+
+func (x *Collector) GetProcessevents() *ProcessEventCounts {
+	if x != nil {
+		return x.Processevents
+	}
+	return nil
+}
+
+func (x *Collector) GetProcessstates() *ProcessStates {
+	if x != nil {
+		return x.Processstates
+	}
+	return nil
+}
+
+func (x *Collector) GetCgroupusage() []*CgroupUsage {
+	if x != nil {
+		return x.Cgroupusage
+	}
+	return nil
+}
+
+func (x *Collector) GetNetworknamespace() []*NetworkNamespace {
+	if x != nil {
+		return x.Networknamespace
+	}
+	return nil
+}
+
+func (x *Collector) GetFiledescriptors() *FileDescriptorUsage {
+	if x != nil {
+		return x.Filedescriptors
+	}
+	return nil
+}
+
+func (x *Collector) GetSensors() *Sensors {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *Collector) GetStatsd() *StatsD {
+	if x != nil {
+		return x.Statsd
+	}
+	return nil
+}
+
+func (x *Collector) GetMemory() *MemoryUsage {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *Collector) GetNetworkinterface() []*NetworkInterface {
+	if x != nil {
+		return x.Networkinterface
+	}
+	return nil
+}
+
+var File_api_pb_metrics_proto protoreflect.FileDescriptor
+
+var file_api_pb_metrics_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xca, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6d, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65,
+	0x79, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x33,
+	0x0a, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f,
+	0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6b,
+	0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0xf9, 0x01, 0x0a, 0x0f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f,
+	0x70, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x3c, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a,
+	0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x70,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x0f,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0c, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x44, 0x65,
+	0x6c, 0x74, 0x61, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x60, 0x0a, 0x10, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x32,
+	0x0a, 0x08, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x08, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0f, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xe3, 0x02, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x33, 0x0a, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x52, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x09, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xfd,
+	0x01, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x08, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x11,
+	0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xb5, 0x04, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x72,
+	0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x6f,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x65, 0x74, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6f, 0x73, 0x50, 0x72, 0x65, 0x74, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62,
+	0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70,
+	0x75, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x70, 0x75, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x70,
+	0x75, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75,
+	0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x78, 0x0a, 0x0b, 0x4c, 0x6f, 0x61,
+	0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6e, 0x65, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x6e,
+	0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x76, 0x65, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66,
+	0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69,
+	0x66, 0x74, 0x65, 0x65, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x66, 0x69, 0x66, 0x74, 0x65, 0x65, 0x6e, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x08, 0x43, 0x50, 0x55, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c,
+	0x65, 0x22, 0x4d, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x74, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x62, 0x70, 0x65, 0x72, 0x73, 0x65, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6b, 0x62, 0x70, 0x65, 0x72, 0x73, 0x65, 0x63,
+	0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x64, 0x6d, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x64, 0x6d, 0x62, 0x12, 0x21, 0x0a,
+	0x0c, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x0f, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x69, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x69, 0x70,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x73, 0x74, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x73, 0x74, 0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65,
+	0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x62, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x9a, 0x01, 0x0a,
+	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x6f, 0x64, 0x55, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x6f, 0x73, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x6f, 0x73,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3a, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x09, 0x54, 0x43, 0x50,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x76, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0xce, 0x02, 0x0a, 0x0e, 0x4b,
+	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
+	0x6f, 0x63, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x72, 0x71, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x72, 0x75, 0x70, 0x74, 0x52, 0x04, 0x69, 0x72, 0x71, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x73,
+	0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75,
+	0x70, 0x74, 0x52, 0x07, 0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x22, 0xbc, 0x03, 0x0a, 0x0c,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x70,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x73, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x64, 0x73, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x64, 0x73, 0x12, 0x3a,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0xea, 0x02, 0x0a, 0x0e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x70, 0x69, 0x64, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x73,
+	0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x77, 0x61,
+	0x70, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6f, 0x70, 0x65, 0x6e, 0x46, 0x64, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x0c,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78,
+	0x69, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0xee, 0x01, 0x0a, 0x12,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x65, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x78, 0x65, 0x63, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x78, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65,
+	0x78, 0x69, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x66, 0x6f, 0x72, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x69, 0x74,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x69, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x0d,
+	0x5a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70,
+	0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x22, 0xa8, 0x03, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x69,
+	0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x6c, 0x65, 0x65, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x6c, 0x65, 0x65,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x7a,
+	0x6f, 0x6d, 0x62, 0x69, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x7a, 0x6f, 0x6d,
+	0x62, 0x69, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x64, 0x4d, 0x61, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x4d, 0x61, 0x78, 0x12, 0x2a, 0x0a, 0x11, 0x70,
+	0x69, 0x64, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x69, 0x64, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x7a,
+	0x6f, 0x6d, 0x62, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x5a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x07, 0x7a, 0x6f, 0x6d, 0x62, 0x69, 0x65, 0x73, 0x22,
+	0xe4, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65,
+	0x5f, 0x61, 0x76, 0x67, 0x31, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x6f,
+	0x6d, 0x65, 0x41, 0x76, 0x67, 0x31, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x6d, 0x65, 0x5f,
+	0x61, 0x76, 0x67, 0x36, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x6f, 0x6d,
+	0x65, 0x41, 0x76, 0x67, 0x36, 0x30, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6d, 0x65, 0x5f, 0x61,
+	0x76, 0x67, 0x33, 0x30, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x6f, 0x6d,
+	0x65, 0x41, 0x76, 0x67, 0x33, 0x30, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f,
+	0x61, 0x76, 0x67, 0x31, 0x30, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x75, 0x6c,
+	0x6c, 0x41, 0x76, 0x67, 0x31, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61,
+	0x76, 0x67, 0x36, 0x30, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x75, 0x6c, 0x6c,
+	0x41, 0x76, 0x67, 0x36, 0x30, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x76,
+	0x67, 0x33, 0x30, 0x30, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c,
+	0x41, 0x76, 0x67, 0x33, 0x30, 0x30, 0x22, 0x8d, 0x05, 0x0a, 0x0b, 0x43, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70,
+	0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x63,
+	0x70, 0x75, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x55, 0x73, 0x65, 0x72, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x70, 0x75, 0x5f, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x10, 0x63, 0x70, 0x75, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x6e,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x41, 0x6e, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x68, 0x6d, 0x65, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x68, 0x6d, 0x65, 0x6d, 0x12, 0x2b, 0x0a,
+	0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x49, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x69, 0x6f, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x49, 0x6f, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x69, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0xe0, 0x02, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x44, 0x0a, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x43, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09,
+	0x74, 0x63, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x15, 0x46, 0x69,
+	0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x66, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x46, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x22, 0xfc, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x75, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x69, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x69,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x72, 0x65,
+	0x65, 0x49, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x69, 0x64, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x69, 0x64, 0x4d, 0x61, 0x78,
+	0x12, 0x3e, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x4f, 0x0a, 0x0b, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75,
+	0x73, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x48, 0x77, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x68, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x43, 0x50, 0x55, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x68, 0x7a, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x4d,
+	0x68, 0x7a, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4d, 0x68, 0x7a, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x73, 0x22,
+	0xb2, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x74,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54,
+	0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x0c, 0x74, 0x68, 0x65, 0x72,
+	0x6d, 0x61, 0x6c, 0x5a, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x68, 0x77, 0x6d, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x48, 0x77, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x52,
+	0x05, 0x68, 0x77, 0x6d, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0d, 0x63, 0x70, 0x75, 0x5f, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x50, 0x55, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x9c, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x70,
+	0x35, 0x30, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x39, 0x30, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x30, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x39, 0x35, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39,
+	0x35, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x39, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x70, 0x39, 0x39, 0x22, 0x55, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x12, 0x31, 0x0a,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x44, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xd2, 0x02, 0x0a, 0x0b, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
+	0x65, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73,
+	0x77, 0x61, 0x70, 0x55, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22,
+	0x92, 0x02, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x78, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x44, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x22, 0xd1, 0x09, 0x0a, 0x09, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x08,
+	0x63, 0x70, 0x75, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x50, 0x55, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x08, 0x63, 0x70, 0x75, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a,
+	0x09, 0x64, 0x69, 0x73, 0x6b, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x44, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x0f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x38, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x43, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x09, 0x74, 0x63, 0x70, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0f, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x0f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x41, 0x0a, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x41, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0f,
+	0x66, 0x69, 0x6c, 0x65, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x07, 0x73, 0x65, 0x6e,
+	0x73, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x73, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12,
+	0x47, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2a, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x50,
+	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x50, 0x55, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x10,
+	0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x43,
+	0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x04,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x53, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x44, 0x53, 0x10, 0x06,
+	0x2a, 0x90, 0x05, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49,
+	0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4c, 0x4f,
+	0x41, 0x44, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x43, 0x50,
+	0x55, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41,
+	0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x54,
+	0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f,
+	0x52, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x53, 0x10, 0x05, 0x12, 0x1d,
+	0x0a, 0x19, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x06, 0x12, 0x1c, 0x0a,
+	0x18, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x54,
+	0x43, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x53, 0x10, 0x07, 0x12, 0x23, 0x0a, 0x1f, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x08,
+	0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c,
+	0x59, 0x5f, 0x4b, 0x45, 0x52, 0x4e, 0x45, 0x4c, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
+	0x59, 0x10, 0x09, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41,
+	0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x53, 0x10, 0x0a,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c,
+	0x59, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x0b, 0x12, 0x20, 0x0a,
+	0x1c, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x50,
+	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x0c, 0x12,
+	0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59,
+	0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x53, 0x10,
+	0x0d, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49,
+	0x4c, 0x59, 0x5f, 0x43, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x0e, 0x12, 0x1c, 0x0a, 0x18,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x53, 0x10, 0x0f, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x10, 0x12, 0x19,
+	0x0a, 0x15, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f,
+	0x53, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x53, 0x10, 0x11, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x54,
+	0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x44, 0x10, 0x12, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41,
+	0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x13, 0x12, 0x24, 0x0a,
+	0x20, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4e,
+	0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45,
+	0x53, 0x10, 0x14, 0x2a, 0x75, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x43, 0x45,
+	0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4b, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x45, 0x43,
+	0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x10, 0x03, 0x32, 0xce, 0x03, 0x0a, 0x10, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x49, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x51, 0x0a, 0x13, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3e, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x49, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a, 0x0e, 0x2e,
+	0x2f, 0x3b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_pb_metrics_proto_rawDescOnce sync.Once
+	file_api_pb_metrics_proto_rawDescData = file_api_pb_metrics_proto_rawDesc
+)
+
+func file_api_pb_metrics_proto_rawDescGZIP() []byte {
+	file_api_pb_metrics_proto_rawDescOnce.Do(func() {
+		file_api_pb_metrics_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_pb_metrics_proto_rawDescData)
+	})
+	return file_api_pb_metrics_proto_rawDescData
+}
+
+var file_api_pb_metrics_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_pb_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
+var file_api_pb_metrics_proto_goTypes = []interface{}{
+	(ProcessSortKey)(0),           // 0: collector.ProcessSortKey
+	(MetricFamily)(0),             // 1: collector.MetricFamily
+	(ProcessEventType)(0),         // 2: collector.ProcessEventType
+	(*MetricsRequest)(nil),        // 3: collector.MetricsRequest
+	(*SnapshotRequest)(nil),       // 4: collector.SnapshotRequest
+	(*MetricsResponse)(nil),       // 5: collector.MetricsResponse
+	(*TrafficInfoDelta)(nil),      // 6: collector.TrafficInfoDelta
+	(*ListeningSocketDelta)(nil),  // 7: collector.ListeningSocketDelta
+	(*CollectorDelta)(nil),        // 8: collector.CollectorDelta
+	(*FamilyStatus)(nil),          // 9: collector.FamilyStatus
+	(*QueryRangeRequest)(nil),     // 10: collector.QueryRangeRequest
+	(*QueryRangeResponse)(nil),    // 11: collector.QueryRangeResponse
+	(*CapabilitiesRequest)(nil),   // 12: collector.CapabilitiesRequest
+	(*FieldInfo)(nil),             // 13: collector.FieldInfo
+	(*FamilyCapability)(nil),      // 14: collector.FamilyCapability
+	(*Capabilities)(nil),          // 15: collector.Capabilities
+	(*HostInfoRequest)(nil),       // 16: collector.HostInfoRequest
+	(*HostInfo)(nil),              // 17: collector.HostInfo
+	(*LoadAverage)(nil),           // 18: collector.LoadAverage
+	(*CPUUsage)(nil),              // 19: collector.CPUUsage
+	(*DiskUsage)(nil),             // 20: collector.DiskUsage
+	(*FileSystemUsage)(nil),       // 21: collector.FileSystemUsage
+	(*NetworkProtocol)(nil),       // 22: collector.NetworkProtocol
+	(*TrafficInfo)(nil),           // 23: collector.TrafficInfo
+	(*ContainerIdentity)(nil),     // 24: collector.ContainerIdentity
+	(*ListeningSocket)(nil),       // 25: collector.ListeningSocket
+	(*TCPStates)(nil),             // 26: collector.TCPStates
+	(*Interrupt)(nil),             // 27: collector.Interrupt
+	(*KernelActivity)(nil),        // 28: collector.KernelActivity
+	(*ProcessUsage)(nil),          // 29: collector.ProcessUsage
+	(*WatchedProcess)(nil),        // 30: collector.WatchedProcess
+	(*ProcessEventsRequest)(nil),  // 31: collector.ProcessEventsRequest
+	(*ProcessEvent)(nil),          // 32: collector.ProcessEvent
+	(*ProcessEventCounts)(nil),    // 33: collector.ProcessEventCounts
+	(*ZombieProcess)(nil),         // 34: collector.ZombieProcess
+	(*ProcessStates)(nil),         // 35: collector.ProcessStates
+	(*Pressure)(nil),              // 36: collector.Pressure
+	(*CgroupUsage)(nil),           // 37: collector.CgroupUsage
+	(*NetworkNamespace)(nil),      // 38: collector.NetworkNamespace
+	(*FileDescriptorProcess)(nil), // 39: collector.FileDescriptorProcess
+	(*FileDescriptorUsage)(nil),   // 40: collector.FileDescriptorUsage
+	(*ThermalZone)(nil),           // 41: collector.ThermalZone
+	(*HwmonSensor)(nil),           // 42: collector.HwmonSensor
+	(*CPUFrequency)(nil),          // 43: collector.CPUFrequency
+	(*Sensors)(nil),               // 44: collector.Sensors
+	(*StatsDMetric)(nil),          // 45: collector.StatsDMetric
+	(*StatsD)(nil),                // 46: collector.StatsD
+	(*MemoryUsage)(nil),           // 47: collector.MemoryUsage
+	(*NetworkInterface)(nil),      // 48: collector.NetworkInterface
+	(*Collector)(nil),             // 49: collector.Collector
+	(*timestamppb.Timestamp)(nil), // 50: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 51: google.protobuf.Duration
+}
+var file_api_pb_metrics_proto_depIdxs = []int32{
+	0,  // 0: collector.MetricsRequest.process_sort:type_name -> collector.ProcessSortKey
+	1,  // 1: collector.MetricsRequest.families:type_name -> collector.MetricFamily
+	0,  // 2: collector.SnapshotRequest.process_sort:type_name -> collector.ProcessSortKey
+	1,  // 3: collector.SnapshotRequest.families:type_name -> collector.MetricFamily
+	49, // 4: collector.MetricsResponse.collector:type_name -> collector.Collector
+	50, // 5: collector.MetricsResponse.window_start:type_name -> google.protobuf.Timestamp
+	50, // 6: collector.MetricsResponse.window_end:type_name -> google.protobuf.Timestamp
+	9,  // 7: collector.MetricsResponse.family_status:type_name -> collector.FamilyStatus
+	8,  // 8: collector.MetricsResponse.delta:type_name -> collector.CollectorDelta
+	23, // 9: collector.TrafficInfoDelta.upserted:type_name -> collector.TrafficInfo
+	25, // 10: collector.ListeningSocketDelta.upserted:type_name -> collector.ListeningSocket
+	6,  // 11: collector.CollectorDelta.trafficinfo:type_name -> collector.TrafficInfoDelta
+	7,  // 12: collector.CollectorDelta.listeningsocket:type_name -> collector.ListeningSocketDelta
+	1,  // 13: collector.FamilyStatus.family:type_name -> collector.MetricFamily
+	51, // 14: collector.FamilyStatus.duration:type_name -> google.protobuf.Duration
+	50, // 15: collector.QueryRangeRequest.start:type_name -> google.protobuf.Timestamp
+	50, // 16: collector.QueryRangeRequest.end:type_name -> google.protobuf.Timestamp
+	51, // 17: collector.QueryRangeRequest.step:type_name -> google.protobuf.Duration
+	1,  // 18: collector.QueryRangeRequest.families:type_name -> collector.MetricFamily
+	0,  // 19: collector.QueryRangeRequest.process_sort:type_name -> collector.ProcessSortKey
+	5,  // 20: collector.QueryRangeResponse.points:type_name -> collector.MetricsResponse
+	1,  // 21: collector.FamilyCapability.family:type_name -> collector.MetricFamily
+	13, // 22: collector.FamilyCapability.fields:type_name -> collector.FieldInfo
+	14, // 23: collector.Capabilities.families:type_name -> collector.FamilyCapability
+	51, // 24: collector.Capabilities.min_interval:type_name -> google.protobuf.Duration
+	51, // 25: collector.Capabilities.max_interval:type_name -> google.protobuf.Duration
+	51, // 26: collector.Capabilities.max_window:type_name -> google.protobuf.Duration
+	50, // 27: collector.HostInfo.boot_time:type_name -> google.protobuf.Timestamp
+	51, // 28: collector.HostInfo.uptime:type_name -> google.protobuf.Duration
+	24, // 29: collector.TrafficInfo.container:type_name -> collector.ContainerIdentity
+	24, // 30: collector.ListeningSocket.container:type_name -> collector.ContainerIdentity
+	27, // 31: collector.KernelActivity.irqs:type_name -> collector.Interrupt
+	27, // 32: collector.KernelActivity.softirq:type_name -> collector.Interrupt
+	24, // 33: collector.ProcessUsage.container:type_name -> collector.ContainerIdentity
+	2,  // 34: collector.ProcessEventsRequest.types:type_name -> collector.ProcessEventType
+	50, // 35: collector.ProcessEvent.time:type_name -> google.protobuf.Timestamp
+	2,  // 36: collector.ProcessEvent.type:type_name -> collector.ProcessEventType
+	34, // 37: collector.ProcessStates.zombies:type_name -> collector.ZombieProcess
+	36, // 38: collector.CgroupUsage.pressure:type_name -> collector.Pressure
+	24, // 39: collector.CgroupUsage.container:type_name -> collector.ContainerIdentity
+	24, // 40: collector.NetworkNamespace.container:type_name -> collector.ContainerIdentity
+	23, // 41: collector.NetworkNamespace.trafficinfo:type_name -> collector.TrafficInfo
+	25, // 42: collector.NetworkNamespace.listeningsocket:type_name -> collector.ListeningSocket
+	26, // 43: collector.NetworkNamespace.tcpstates:type_name -> collector.TCPStates
+	24, // 44: collector.FileDescriptorProcess.container:type_name -> collector.ContainerIdentity
+	39, // 45: collector.FileDescriptorUsage.processes:type_name -> collector.FileDescriptorProcess
+	41, // 46: collector.Sensors.thermal_zones:type_name -> collector.ThermalZone
+	42, // 47: collector.Sensors.hwmon:type_name -> collector.HwmonSensor
+	43, // 48: collector.Sensors.cpu_frequency:type_name -> collector.CPUFrequency
+	45, // 49: collector.StatsD.metrics:type_name -> collector.StatsDMetric
+	18, // 50: collector.Collector.loadaverage:type_name -> collector.LoadAverage
+	19, // 51: collector.Collector.cpuusage:type_name -> collector.CPUUsage
+	20, // 52: collector.Collector.diskusage:type_name -> collector.DiskUsage
+	21, // 53: collector.Collector.filesystemusage:type_name -> collector.FileSystemUsage
+	22, // 54: collector.Collector.networkprotocol:type_name -> collector.NetworkProtocol
+	23, // 55: collector.Collector.trafficinfo:type_name -> collector.TrafficInfo
+	26, // 56: collector.Collector.tcpstates:type_name -> collector.TCPStates
+	25, // 57: collector.Collector.listeningsocket:type_name -> collector.ListeningSocket
+	28, // 58: collector.Collector.kernelactivity:type_name -> collector.KernelActivity
+	29, // 59: collector.Collector.processusage:type_name -> collector.ProcessUsage
+	30, // 60: collector.Collector.watchedprocess:type_name -> collector.WatchedProcess
+	33, // 61: collector.Collector.processevents:type_name -> collector.ProcessEventCounts
+	35, // 62: collector.Collector.processstates:type_name -> collector.ProcessStates
+	37, // 63: collector.Collector.cgroupusage:type_name -> collector.CgroupUsage
+	38, // 64: collector.Collector.networknamespace:type_name -> collector.NetworkNamespace
+	40, // 65: collector.Collector.filedescriptors:type_name -> collector.FileDescriptorUsage
+	44, // 66: collector.Collector.sensors:type_name -> collector.Sensors
+	46, // 67: collector.Collector.statsd:type_name -> collector.StatsD
+	47, // 68: collector.Collector.memory:type_name -> collector.MemoryUsage
+	48, // 69: collector.Collector.networkinterface:type_name -> collector.NetworkInterface
+	3,  // 70: collector.MetricsCollector.CollectMetrics:input_type -> collector.MetricsRequest
+	31, // 71: collector.MetricsCollector.StreamProcessEvents:input_type -> collector.ProcessEventsRequest
+	16, // 72: collector.MetricsCollector.GetHostInfo:input_type -> collector.HostInfoRequest
+	4,  // 73: collector.MetricsCollector.GetSnapshot:input_type -> collector.SnapshotRequest
+	12, // 74: collector.MetricsCollector.GetCapabilities:input_type -> collector.CapabilitiesRequest
+	10, // 75: collector.MetricsCollector.QueryRange:input_type -> collector.QueryRangeRequest
+	5,  // 76: collector.MetricsCollector.CollectMetrics:output_type -> collector.MetricsResponse
+	32, // 77: collector.MetricsCollector.StreamProcessEvents:output_type -> collector.ProcessEvent
+	17, // 78: collector.MetricsCollector.GetHostInfo:output_type -> collector.HostInfo
+	5,  // 79: collector.MetricsCollector.GetSnapshot:output_type -> collector.MetricsResponse
+	15, // 80: collector.MetricsCollector.GetCapabilities:output_type -> collector.Capabilities
+	11, // 81: collector.MetricsCollector.QueryRange:output_type -> collector.QueryRangeResponse
+	76, // [76:82] is the sub-list for method output_type
+	70, // [70:76] is the sub-list for method input_type
+	70, // [70:70] is the sub-list for extension type_name
+	70, // [70:70] is the sub-list for extension extendee
+	0,  // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_api_pb_metrics_proto_init() }
@@ -972,8 +5435,152 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricsResponse); i {
+		file_api_pb_metrics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficInfoDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListeningSocketDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FamilyStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FamilyCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Capabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -984,7 +5591,19 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoadAverage); i {
 			case 0:
 				return &v.state
@@ -996,7 +5615,7 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CPUUsage); i {
 			case 0:
 				return &v.state
@@ -1008,7 +5627,7 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiskUsage); i {
 			case 0:
 				return &v.state
@@ -1020,7 +5639,7 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileSystemUsage); i {
 			case 0:
 				return &v.state
@@ -1032,7 +5651,7 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NetworkProtocol); i {
 			case 0:
 				return &v.state
@@ -1044,7 +5663,7 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficInfo); i {
 			case 0:
 				return &v.state
@@ -1056,7 +5675,19 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListeningSocket); i {
 			case 0:
 				return &v.state
@@ -1068,7 +5699,7 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TCPStates); i {
 			case 0:
 				return &v.state
@@ -1080,7 +5711,271 @@ func file_api_pb_metrics_proto_init() {
 				return nil
 			}
 		}
-		file_api_pb_metrics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_pb_metrics_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interrupt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KernelActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedProcess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessEventCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ZombieProcess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pressure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDescriptorProcess); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileDescriptorUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThermalZone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HwmonSensor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CPUFrequency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sensors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsDMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsD); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_pb_metrics_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Collector); i {
 			case 0:
 				return &v.state
@@ -1098,13 +5993,14 @@ func file_api_pb_metrics_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_pb_metrics_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_pb_metrics_proto_goTypes,
 		DependencyIndexes: file_api_pb_metrics_proto_depIdxs,
+		EnumInfos:         file_api_pb_metrics_proto_enumTypes,
 		MessageInfos:      file_api_pb_metrics_proto_msgTypes,
 	}.Build()
 	File_api_pb_metrics_proto = out.File
@@ -23,6 +23,11 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MetricsCollectorClient interface {
 	CollectMetrics(ctx context.Context, in *MetricsRequest, opts ...grpc.CallOption) (MetricsCollector_CollectMetricsClient, error)
+	StreamProcessEvents(ctx context.Context, in *ProcessEventsRequest, opts ...grpc.CallOption) (MetricsCollector_StreamProcessEventsClient, error)
+	GetHostInfo(ctx context.Context, in *HostInfoRequest, opts ...grpc.CallOption) (*HostInfo, error)
+	GetSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	GetCapabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*Capabilities, error)
+	QueryRange(ctx context.Context, in *QueryRangeRequest, opts ...grpc.CallOption) (*QueryRangeResponse, error)
 }
 
 type metricsCollectorClient struct {
@@ -65,11 +70,84 @@ func (x *metricsCollectorCollectMetricsClient) Recv() (*MetricsResponse, error)
 	return m, nil
 }
 
+func (c *metricsCollectorClient) StreamProcessEvents(ctx context.Context, in *ProcessEventsRequest, opts ...grpc.CallOption) (MetricsCollector_StreamProcessEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MetricsCollector_ServiceDesc.Streams[1], "/collector.MetricsCollector/StreamProcessEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metricsCollectorStreamProcessEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MetricsCollector_StreamProcessEventsClient interface {
+	Recv() (*ProcessEvent, error)
+	grpc.ClientStream
+}
+
+type metricsCollectorStreamProcessEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *metricsCollectorStreamProcessEventsClient) Recv() (*ProcessEvent, error) {
+	m := new(ProcessEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *metricsCollectorClient) GetHostInfo(ctx context.Context, in *HostInfoRequest, opts ...grpc.CallOption) (*HostInfo, error) {
+	out := new(HostInfo)
+	err := c.cc.Invoke(ctx, "/collector.MetricsCollector/GetHostInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsCollectorClient) GetSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, "/collector.MetricsCollector/GetSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsCollectorClient) GetCapabilities(ctx context.Context, in *CapabilitiesRequest, opts ...grpc.CallOption) (*Capabilities, error) {
+	out := new(Capabilities)
+	err := c.cc.Invoke(ctx, "/collector.MetricsCollector/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsCollectorClient) QueryRange(ctx context.Context, in *QueryRangeRequest, opts ...grpc.CallOption) (*QueryRangeResponse, error) {
+	out := new(QueryRangeResponse)
+	err := c.cc.Invoke(ctx, "/collector.MetricsCollector/QueryRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetricsCollectorServer is the server API for MetricsCollector service.
 // All implementations must embed UnimplementedMetricsCollectorServer
 // for forward compatibility
 type MetricsCollectorServer interface {
 	CollectMetrics(*MetricsRequest, MetricsCollector_CollectMetricsServer) error
+	StreamProcessEvents(*ProcessEventsRequest, MetricsCollector_StreamProcessEventsServer) error
+	GetHostInfo(context.Context, *HostInfoRequest) (*HostInfo, error)
+	GetSnapshot(context.Context, *SnapshotRequest) (*MetricsResponse, error)
+	GetCapabilities(context.Context, *CapabilitiesRequest) (*Capabilities, error)
+	QueryRange(context.Context, *QueryRangeRequest) (*QueryRangeResponse, error)
 	mustEmbedUnimplementedMetricsCollectorServer()
 }
 
@@ -80,6 +158,21 @@ type UnimplementedMetricsCollectorServer struct {
 func (UnimplementedMetricsCollectorServer) CollectMetrics(*MetricsRequest, MetricsCollector_CollectMetricsServer) error {
 	return status.Errorf(codes.Unimplemented, "method CollectMetrics not implemented")
 }
+func (UnimplementedMetricsCollectorServer) StreamProcessEvents(*ProcessEventsRequest, MetricsCollector_StreamProcessEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProcessEvents not implemented")
+}
+func (UnimplementedMetricsCollectorServer) GetHostInfo(context.Context, *HostInfoRequest) (*HostInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostInfo not implemented")
+}
+func (UnimplementedMetricsCollectorServer) GetSnapshot(context.Context, *SnapshotRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
+func (UnimplementedMetricsCollectorServer) GetCapabilities(context.Context, *CapabilitiesRequest) (*Capabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedMetricsCollectorServer) QueryRange(context.Context, *QueryRangeRequest) (*QueryRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRange not implemented")
+}
 func (UnimplementedMetricsCollectorServer) mustEmbedUnimplementedMetricsCollectorServer() {}
 
 // UnsafeMetricsCollectorServer may be embedded to opt out of forward compatibility for this service.
@@ -114,19 +207,134 @@ func (x *metricsCollectorCollectMetricsServer) Send(m *MetricsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MetricsCollector_StreamProcessEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MetricsCollectorServer).StreamProcessEvents(m, &metricsCollectorStreamProcessEventsServer{stream})
+}
+
+type MetricsCollector_StreamProcessEventsServer interface {
+	Send(*ProcessEvent) error
+	grpc.ServerStream
+}
+
+type metricsCollectorStreamProcessEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *metricsCollectorStreamProcessEventsServer) Send(m *ProcessEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MetricsCollector_GetHostInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsCollectorServer).GetHostInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/collector.MetricsCollector/GetHostInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsCollectorServer).GetHostInfo(ctx, req.(*HostInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsCollector_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsCollectorServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/collector.MetricsCollector/GetSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsCollectorServer).GetSnapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsCollector_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsCollectorServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/collector.MetricsCollector/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsCollectorServer).GetCapabilities(ctx, req.(*CapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsCollector_QueryRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsCollectorServer).QueryRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/collector.MetricsCollector/QueryRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsCollectorServer).QueryRange(ctx, req.(*QueryRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MetricsCollector_ServiceDesc is the grpc.ServiceDesc for MetricsCollector service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var MetricsCollector_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "collector.MetricsCollector",
 	HandlerType: (*MetricsCollectorServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHostInfo",
+			Handler:    _MetricsCollector_GetHostInfo_Handler,
+		},
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _MetricsCollector_GetSnapshot_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _MetricsCollector_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "QueryRange",
+			Handler:    _MetricsCollector_QueryRange_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "CollectMetrics",
 			Handler:       _MetricsCollector_CollectMetrics_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamProcessEvents",
+			Handler:       _MetricsCollector_StreamProcessEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/pb/metrics.proto",
 }
@@ -6,9 +6,9 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 
 	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
-	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
 	"github.com/Gilfoyle3301/system-stats-daemon/internal/config"
 	"github.com/Gilfoyle3301/system-stats-daemon/internal/grpcserver"
 	"github.com/olekukonko/tablewriter"
@@ -36,7 +36,7 @@ func main() {
 		log.Fatalf("failed read config: %v", err)
 	}
 
-	go grpcserver.StartServer(&collector.Collector{}, grpcport)
+	go grpcserver.StartServer(getParams, grpcport)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
@@ -48,8 +48,11 @@ func main() {
 
 	daemonClient := collectorpb.NewMetricsCollectorClient(conn)
 	req := collectorpb.MetricsRequest{
-		NSecond: int32(getParams.Interval),
-		MSecond: 60,
+		NSecond:      int32(getParams.Interval),
+		MSecond:      60,
+		TopProcesses: int32(getParams.Processes.Top),
+		ProcessSort:  collectorpb.ProcessSortKey(collectorpb.ProcessSortKey_value["PROCESS_SORT_"+strings.ToUpper(getParams.Processes.SortBy)]),
+		Families:     grpcserver.EnabledFamilies(getParams),
 	}
 
 	stream, err := daemonClient.CollectMetrics(context.Background(), &req)
@@ -77,6 +80,9 @@ func displayTable(resp *collectorpb.MetricsResponse, getParams *config.Config) {
 	if getParams.Metrics.EnableCPU {
 		table.Append([]string{"CPU Usage", fmt.Sprintf("%+v", resp.GetCollector().Cpuusage)})
 	}
+	if getParams.Metrics.EnableMemory {
+		table.Append([]string{"Memory", fmt.Sprintf("%+v", resp.GetCollector().Memory)})
+	}
 	if getParams.Metrics.EnableDiskUsage {
 		table.Append([]string{"Disk Usage", fmt.Sprintf("%+v", resp.GetCollector().Diskusage)})
 	}
@@ -88,6 +94,42 @@ func displayTable(resp *collectorpb.MetricsResponse, getParams *config.Config) {
 		table.Append([]string{"", fmt.Sprintf("%+v", resp.GetCollector().Listeningsocket)})
 		table.Append([]string{"", fmt.Sprintf("%+v", resp.GetCollector().Tcpstates)})
 		table.Append([]string{"", fmt.Sprintf("%+v", resp.GetCollector().Trafficinfo)})
+		table.Append([]string{"Network Interfaces", fmt.Sprintf("%+v", resp.GetCollector().Networkinterface)})
+	}
+	if getParams.Metrics.EnableProcesses {
+		for _, process := range resp.GetCollector().Processusage {
+			table.Append([]string{"Process", fmt.Sprintf("%+v", process)})
+		}
+	}
+	if getParams.Metrics.EnableProcessStates {
+		table.Append([]string{"Process States", fmt.Sprintf("%+v", resp.GetCollector().Processstates)})
+	}
+	if getParams.Metrics.EnableCgroups {
+		for _, cgroup := range resp.GetCollector().Cgroupusage {
+			table.Append([]string{"Cgroup " + cgroup.Path, fmt.Sprintf("%+v", cgroup)})
+		}
+	}
+	if getParams.Metrics.EnableNamespaces {
+		for _, namespace := range resp.GetCollector().Networknamespace {
+			table.Append([]string{fmt.Sprintf("Net namespace %d", namespace.Inode), fmt.Sprintf("%+v", namespace)})
+		}
+	}
+	if getParams.Metrics.EnableFileDescriptors {
+		table.Append([]string{"File Descriptors", fmt.Sprintf("%+v", resp.GetCollector().Filedescriptors)})
+	}
+	if getParams.Metrics.EnableSensors {
+		table.Append([]string{"Sensors", fmt.Sprintf("%+v", resp.GetCollector().Sensors)})
+	}
+	if getParams.Metrics.EnableStatsD {
+		for _, metric := range resp.GetCollector().GetStatsd().GetMetrics() {
+			table.Append([]string{"StatsD " + metric.Name, fmt.Sprintf("%+v", metric)})
+		}
+	}
+	for _, watched := range resp.GetCollector().Watchedprocess {
+		table.Append([]string{"Watched " + watched.Name, fmt.Sprintf("%+v", watched)})
+	}
+	if getParams.Metrics.EnableKernelActivity {
+		table.Append([]string{"Kernel Activity", fmt.Sprintf("%+v", resp.GetCollector().Kernelactivity)})
 	}
 
 	table.Render()
@@ -0,0 +1,52 @@
+package collector
+
+import (
+	"os"
+	"path/filepath"
+)
+
+func exists(file string) bool {
+	_, err := os.Stat(file)
+	return err == nil
+}
+
+func anyExists(pattern string) bool {
+	matches, _ := filepath.Glob(pattern)
+	return len(matches) > 0
+}
+
+// Supported reports whether the running kernel exposes what a family reads.
+func Supported(family string) bool {
+	switch family {
+	case FamilyLoadAverage:
+		return exists("/proc/loadavg")
+	case FamilyCPU, FamilyKernelActivity:
+		return exists("/proc/stat")
+	case FamilyMemory:
+		return exists("/proc/meminfo")
+	case FamilyDisk:
+		return exists("/proc/diskstats")
+	case FamilyFileSystems:
+		return exists("/proc/mounts")
+	case FamilyNetwork:
+		return exists("/proc/net/tcp")
+	case FamilyProcesses:
+		return exists("/proc/self/stat")
+	case FamilyProcessStates:
+		return exists("/proc/sys/kernel/pid_max")
+	case FamilyCgroups:
+		return exists(filepath.Join(cgroupRoot(), "cgroup.controllers"))
+	case FamilyNamespaces:
+		return exists("/proc/self/ns/net")
+	case FamilyFileDescriptors:
+		return exists("/proc/sys/fs/file-nr")
+	case FamilySensors:
+		return anyExists(filepath.Join(SysRoot, "class", "thermal", "thermal_zone*")) ||
+			anyExists(filepath.Join(SysRoot, "class", "hwmon", "hwmon*")) ||
+			anyExists(filepath.Join(SysRoot, "devices", "system", "cpu", "cpu[0-9]*", "cpufreq"))
+	case FamilyStatsD:
+		// The listener needs nothing from the kernel beyond a socket.
+		return true
+	}
+	return false
+}
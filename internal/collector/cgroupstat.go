@@ -0,0 +1,283 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Pressure struct {
+	Resource   string
+	SomeAvg10  float64
+	SomeAvg60  float64
+	SomeAvg300 float64
+	FullAvg10  float64
+	FullAvg60  float64
+	FullAvg300 float64
+}
+
+type CgroupUsage struct {
+	Path             string
+	CPUPercent       float64
+	CPUUserPercent   float64
+	CPUSystemPercent float64
+	ThrottledPercent float64
+	MemoryBytes      uint64
+	MemoryAnon       uint64
+	MemoryFile       uint64
+	MemoryKernel     uint64
+	MemoryShmem      uint64
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+	ReadIOPS         float64
+	WriteIOPS        float64
+	Pids             uint64
+	Pressure         []Pressure
+	Container        ContainerIdentity
+}
+
+type cgroupCounters struct {
+	usageUsec     uint64
+	userUsec      uint64
+	systemUsec    uint64
+	throttledUsec uint64
+	memory        uint64
+	memoryStat    map[string]uint64
+	rbytes        uint64
+	wbytes        uint64
+	rios          uint64
+	wios          uint64
+	pids          uint64
+	pressure      []Pressure
+}
+
+func cgroupRoot() string {
+	return filepath.Join(SysRoot, "fs", "cgroup")
+}
+
+func readKeyValues(file string) (map[string]uint64, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]uint64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		values[fields[0]] = value
+	}
+	return values, scanner.Err()
+}
+
+func readUint(file string) (uint64, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	value := strings.TrimSpace(string(data))
+	if value == "max" {
+		return 0, nil
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// readIOStat sums io.stat over every device of the cgroup.
+func readIOStat(file string, counters *cgroupCounters) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		for _, field := range fields[min(1, len(fields)):] {
+			key, raw, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			value, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				counters.rbytes += value
+			case "wbytes":
+				counters.wbytes += value
+			case "rios":
+				counters.rios += value
+			case "wios":
+				counters.wios += value
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// ReadPressure parses a PSI file such as /proc/pressure/cpu or a cgroup's cpu.pressure.
+func ReadPressure(resource, file string) (Pressure, error) {
+	objectPressure := Pressure{Resource: resource}
+	f, err := os.Open(file)
+	if err != nil {
+		return objectPressure, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		var avg10, avg60, avg300 *float64
+		switch fields[0] {
+		case "some":
+			avg10, avg60, avg300 = &objectPressure.SomeAvg10, &objectPressure.SomeAvg60, &objectPressure.SomeAvg300
+		case "full":
+			avg10, avg60, avg300 = &objectPressure.FullAvg10, &objectPressure.FullAvg60, &objectPressure.FullAvg300
+		default:
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, raw, _ := strings.Cut(field, "=")
+			value, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "avg10":
+				*avg10 = value
+			case "avg60":
+				*avg60 = value
+			case "avg300":
+				*avg300 = value
+			}
+		}
+	}
+	return objectPressure, scanner.Err()
+}
+
+func readCgroup(dir string) cgroupCounters {
+	var counters cgroupCounters
+	if cpu, err := readKeyValues(filepath.Join(dir, "cpu.stat")); err == nil {
+		counters.usageUsec = cpu["usage_usec"]
+		counters.userUsec = cpu["user_usec"]
+		counters.systemUsec = cpu["system_usec"]
+		counters.throttledUsec = cpu["throttled_usec"]
+	}
+	counters.memory, _ = readUint(filepath.Join(dir, "memory.current"))
+	counters.memoryStat, _ = readKeyValues(filepath.Join(dir, "memory.stat"))
+	counters.pids, _ = readUint(filepath.Join(dir, "pids.current"))
+	_ = readIOStat(filepath.Join(dir, "io.stat"), &counters)
+	for _, resource := range []string{"cpu", "memory", "io"} {
+		if pressure, err := ReadPressure(resource, filepath.Join(dir, resource+".pressure")); err == nil {
+			counters.pressure = append(counters.pressure, pressure)
+		}
+	}
+	return counters
+}
+
+func cgroupIncluded(rel string, include []string) bool {
+	if len(include) == 0 {
+		return true
+	}
+	for _, pattern := range include {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func cgroupCheck(depth int, include []string) (map[string]cgroupCounters, error) {
+	root := cgroupRoot()
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); err != nil {
+		return nil, fmt.Errorf("cgroup v2 is not mounted at %s: %w", root, err)
+	}
+	objectCgroup := make(map[string]cgroupCounters)
+	err := filepath.WalkDir(root, func(dir string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, dir)
+		if err != nil || rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if depth > 0 && strings.Count(rel, "/") >= depth {
+			return filepath.SkipDir
+		}
+		if cgroupIncluded(rel, include) {
+			objectCgroup[rel] = readCgroup(dir)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+	return objectCgroup, nil
+}
+
+// CgroupStat samples the cgroup v2 hierarchy over one second, down to depth levels below the root
+// and restricted to the include patterns when any are given.
+func CgroupStat(depth int, include []string) ([]CgroupUsage, error) {
+	initValue, err := cgroupCheck(depth, include)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	time.Sleep(1 * time.Second)
+	deltaValue, err := cgroupCheck(depth, include)
+	if err != nil {
+		return nil, err
+	}
+	elapsed := time.Since(start).Seconds()
+
+	stat := make([]CgroupUsage, 0, len(deltaValue))
+	for name, data := range deltaValue {
+		usage := CgroupUsage{
+			Path:         name,
+			MemoryBytes:  data.memory,
+			MemoryAnon:   data.memoryStat["anon"],
+			MemoryFile:   data.memoryStat["file"],
+			MemoryKernel: data.memoryStat["kernel"],
+			MemoryShmem:  data.memoryStat["shmem"],
+			Pids:         data.pids,
+			Pressure:     data.pressure,
+			Container:    ResolveCgroup(name),
+		}
+		if prev, ok := initValue[name]; ok {
+			usage.CPUPercent = rate(prev.usageUsec, data.usageUsec, elapsed) / 1e4
+			usage.CPUUserPercent = rate(prev.userUsec, data.userUsec, elapsed) / 1e4
+			usage.CPUSystemPercent = rate(prev.systemUsec, data.systemUsec, elapsed) / 1e4
+			usage.ThrottledPercent = rate(prev.throttledUsec, data.throttledUsec, elapsed) / 1e4
+			usage.ReadBytesPerSec = rate(prev.rbytes, data.rbytes, elapsed)
+			usage.WriteBytesPerSec = rate(prev.wbytes, data.wbytes, elapsed)
+			usage.ReadIOPS = rate(prev.rios, data.rios, elapsed)
+			usage.WriteIOPS = rate(prev.wios, data.wios, elapsed)
+		}
+		stat = append(stat, usage)
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		return stat[i].Path < stat[j].Path
+	})
+	return stat, nil
+}
@@ -1,5 +1,13 @@
 package collector
 
+import (
+	"sync"
+	"time"
+)
+
+// SysRoot is where sysfs is mounted, tests point it at a fixture tree.
+var SysRoot = "/sys"
+
 type LoadAverage struct {
 	OneMinute      float64
 	FiveMinutes    float64
@@ -20,6 +28,7 @@ type DiskUsage struct {
 
 type FileSystemUsage struct {
 	FileSystem   string
+	MountPoint   string
 	UsedMB       float64
 	UsedPercent  float64
 	UsedInode    float64
@@ -33,11 +42,12 @@ type NetworkProtocol struct {
 }
 
 type ListeningSocket struct {
-	Command  string
-	PID      int
-	User     string
-	Protocol string
-	Port     int
+	Command   string
+	PID       int
+	User      string
+	Protocol  string
+	Port      int
+	Container ContainerIdentity
 }
 
 type TCPStates struct {
@@ -45,43 +55,258 @@ type TCPStates struct {
 	Count int
 }
 
+type Interrupt struct {
+	Name        string
+	Description string
+	Count       uint64
+	PerSec      float64
+}
+
+type KernelActivity struct {
+	ContextSwitchesPerSec float64
+	InterruptsPerSec      float64
+	ForksPerSec           float64
+	SoftIRQPerSec         float64
+	ProcsRunning          uint64
+	ProcsBlocked          uint64
+	BootTime              uint64
+	Interrupts            []Interrupt
+	SoftIRQs              []Interrupt
+}
+
+type ProcessUsage struct {
+	PID              int
+	PPID             int
+	Command          string
+	Cmdline          string
+	User             string
+	State            string
+	CPUPercent       float64
+	RSSBytes         uint64
+	SwapBytes        uint64
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+	Threads          int
+	OpenFDs          int
+	Container        ContainerIdentity
+}
+
+// Options toggles the optional parts of a collection.
+type Options struct {
+	LoadAverage    bool
+	CPU            bool
+	Memory         bool
+	Disk           bool
+	FileSystems    bool
+	Network        bool
+	KernelActivity bool
+	Interrupts     bool
+	Processes      bool
+	ProcessStates  bool
+	Watchlist      *Watchlist
+	ProcEvents     *ProcEvents
+	Cgroups        bool
+	// CgroupDepth limits how deep below the cgroup root to walk, zero walks the whole tree.
+	CgroupDepth     int
+	CgroupInclude   []string
+	Namespaces      bool
+	FileDescriptors bool
+	// FDThreshold is the percentage of RLIMIT_NOFILE above which a process is reported.
+	FDThreshold float64
+	Sensors     bool
+	StatsD      *StatsD
+}
+
+// Families name the collectors a collection runs, a family is measured as a whole.
+const (
+	FamilyLoadAverage     = "load_average"
+	FamilyCPU             = "cpu"
+	FamilyMemory          = "memory"
+	FamilyDisk            = "disk"
+	FamilyFileSystems     = "filesystems"
+	FamilyNetwork         = "network"
+	FamilyKernelActivity  = "kernel_activity"
+	FamilyProcesses       = "processes"
+	FamilyProcessStates   = "process_states"
+	FamilyCgroups         = "cgroups"
+	FamilyNamespaces      = "namespaces"
+	FamilyFileDescriptors = "file_descriptors"
+	FamilySensors         = "sensors"
+	FamilyStatsD          = "statsd"
+)
+
+// FamilyStatus records how long a family took to collect and why it failed.
+type FamilyStatus struct {
+	Duration time.Duration
+	Err      error
+}
+
 type Collector struct {
+	Time            time.Time
+	Sequence        uint64
+	Status          map[string]FamilyStatus
 	LoadAverage     LoadAverage
 	CPUUsage        CPUUsage
+	Memory          MemoryUsage
 	DiskUsage       []DiskUsage
 	FileSystemUsage []FileSystemUsage
 	NetworkProtocol []NetworkProtocol
 	TrafficInfo     []TrafficInfo
 	TCPStates       []TCPStates
+	Interfaces      []NetworkInterface
 	ListeningSocket []ListeningSocket
+	KernelActivity  KernelActivity
+	ProcessUsage    []ProcessUsage
+	WatchedProcess  []WatchedProcess
+	ProcessEvents   ProcessEventCounts
+	ProcessStates   ProcessStates
+	CgroupUsage     []CgroupUsage
+	Namespaces      []NetworkNamespace
+	FileDescriptors FileDescriptorUsage
+	Sensors         Sensors
+	StatsD          StatsDMetrics
 }
 
-func Collect() *Collector {
+func Collect(opts Options) *Collector {
 	var (
 		loadAvg          LoadAverage
 		cpuUsage         CPUUsage
+		memory           MemoryUsage
 		diskUsage        []DiskUsage
 		fileSystemUsage  []FileSystemUsage
 		networkProtocols []NetworkProtocol
 		trafficInfo      []TrafficInfo
 		tcpStates        []TCPStates
+		interfaces       []NetworkInterface
 		listeningSocket  []ListeningSocket
+		kernelActivity   KernelActivity
+		processUsage     []ProcessUsage
+		watchedProcess   []WatchedProcess
+		processStates    ProcessStates
+		cgroupUsage      []CgroupUsage
+		namespaces       []NetworkNamespace
+		fileDescriptors  FileDescriptorUsage
+		sensors          Sensors
+		statsd           StatsDMetrics
+		status           = make(map[string]FamilyStatus)
+		mu               sync.Mutex
+		wg               sync.WaitGroup
 	)
+	measure := func(family string, enabled bool, collect func() error) {
+		if !enabled {
+			return
+		}
+		start := time.Now()
+		err := collect()
+		mu.Lock()
+		status[family] = FamilyStatus{Duration: time.Since(start), Err: err}
+		mu.Unlock()
+	}
+
+	measure(FamilyLoadAverage, opts.LoadAverage, func() (err error) {
+		loadAvg, err = LoadAvg()
+		return err
+	})
+	measure(FamilyMemory, opts.Memory, func() (err error) {
+		memory, err = MemoryStat()
+		return err
+	})
+	measure(FamilyFileSystems, opts.FileSystems, func() error {
+		fileSystemUsage = FsStat()
+		return nil
+	})
+	measure(FamilyProcessStates, opts.ProcessStates, func() (err error) {
+		processStates, err = ProcessStateStat()
+		return err
+	})
+	measure(FamilyNamespaces, opts.Namespaces, func() (err error) {
+		namespaces, err = NamespaceStat()
+		return err
+	})
+	measure(FamilyFileDescriptors, opts.FileDescriptors, func() (err error) {
+		fileDescriptors, err = FileDescriptorStat(opts.FDThreshold)
+		return err
+	})
+	measure(FamilySensors, opts.Sensors, func() error {
+		sensors = SensorStat()
+		return nil
+	})
+	measure(FamilyStatsD, opts.StatsD != nil, func() error {
+		statsd = opts.StatsD.Snapshot()
+		return nil
+	})
 
-	loadAvg, _ = LoadAvg()
-	cpuUsage, _ = CpuStat()
-	diskUsage, _ = DiskStat()
-	fileSystemUsage = FsStat()
-	networkProtocols, trafficInfo, tcpStates, listeningSocket = TrafficGetInfo()
+	// The collectors below sample over a one second window each, run them side by side.
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		measure(FamilyCPU, opts.CPU, func() (err error) {
+			cpuUsage, err = CpuStat()
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		measure(FamilyDisk, opts.Disk, func() (err error) {
+			diskUsage, err = DiskStat()
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		measure(FamilyKernelActivity, opts.KernelActivity, func() (err error) {
+			kernelActivity, err = KernelStat(opts.Interrupts)
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		measure(FamilyProcesses, opts.Processes || opts.Watchlist.Len() > 0, func() (err error) {
+			processUsage, err = ProcessStat()
+			watchedProcess = opts.Watchlist.Match(processUsage)
+			if !opts.Processes {
+				processUsage = nil
+			}
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		measure(FamilyCgroups, opts.Cgroups, func() (err error) {
+			cgroupUsage, err = CgroupStat(opts.CgroupDepth, opts.CgroupInclude)
+			return err
+		})
+	}()
+	measure(FamilyNetwork, opts.Network, func() (err error) {
+		networkProtocols, trafficInfo, tcpStates, listeningSocket = TrafficGetInfo()
+		interfaces, err = NetDevStat()
+		return err
+	})
+	wg.Wait()
+	pruneIdentities()
 
 	return &Collector{
+		Time:            time.Now(),
+		Status:          status,
 		LoadAverage:     loadAvg,
 		CPUUsage:        cpuUsage,
+		Memory:          memory,
 		DiskUsage:       diskUsage,
 		FileSystemUsage: fileSystemUsage,
 		NetworkProtocol: networkProtocols,
 		TCPStates:       tcpStates,
 		TrafficInfo:     trafficInfo,
+		Interfaces:      interfaces,
 		ListeningSocket: listeningSocket,
+		KernelActivity:  kernelActivity,
+		ProcessUsage:    processUsage,
+		WatchedProcess:  watchedProcess,
+		ProcessEvents:   opts.ProcEvents.Counts(),
+		ProcessStates:   processStates,
+		CgroupUsage:     cgroupUsage,
+		Namespaces:      namespaces,
+		FileDescriptors: fileDescriptors,
+		Sensors:         sensors,
+		StatsD:          statsd,
 	}
 }
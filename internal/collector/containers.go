@@ -0,0 +1,231 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	RuntimeDocker     = "docker"
+	RuntimeContainerd = "containerd"
+	RuntimeCRIO       = "cri-o"
+	RuntimePodman     = "podman"
+)
+
+// ContainerIdentity is what can be told about a workload from its cgroup path alone.
+type ContainerIdentity struct {
+	Runtime     string
+	ContainerID string
+	PodUID      string
+	QoSClass    string
+	Unit        string
+}
+
+var systemdUnitSuffixes = []string{".service", ".scope", ".slice", ".socket", ".mount", ".swap"}
+
+var runtimePrefixes = []struct {
+	prefix  string
+	runtime string
+}{
+	{"docker-", RuntimeDocker},
+	{"cri-containerd-", RuntimeContainerd},
+	{"crio-", RuntimeCRIO},
+	{"libpod-", RuntimePodman},
+}
+
+var qosClasses = map[string]string{
+	"burstable":  "burstable",
+	"besteffort": "besteffort",
+	"guaranteed": "guaranteed",
+}
+
+func isContainerID(id string) bool {
+	if len(id) < 12 {
+		return false
+	}
+	for _, c := range id {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func trimUnitSuffix(element string) (string, bool) {
+	for _, suffix := range systemdUnitSuffixes {
+		if strings.HasSuffix(element, suffix) {
+			return strings.TrimSuffix(element, suffix), true
+		}
+	}
+	return element, false
+}
+
+// parseKubepods handles both "kubepods-burstable-pod<uid>" (systemd driver) and the
+// separate "kubepods", "burstable", "pod<uid>" elements of the cgroupfs driver.
+func parseKubepods(name string, identity *ContainerIdentity) bool {
+	switch {
+	case name == "kubepods":
+		return true
+	case strings.HasPrefix(name, "kubepods-"):
+		for _, part := range strings.Split(strings.TrimPrefix(name, "kubepods-"), "-") {
+			if qos, ok := qosClasses[part]; ok {
+				identity.QoSClass = qos
+			} else if strings.HasPrefix(part, "pod") {
+				identity.PodUID = strings.ReplaceAll(strings.TrimPrefix(part, "pod"), "_", "-")
+			}
+		}
+		return true
+	case identity.QoSClass == "" && identity.PodUID == "" && qosClasses[name] != "":
+		identity.QoSClass = qosClasses[name]
+		return true
+	case strings.HasPrefix(name, "pod") && len(name) > len("pod"):
+		identity.PodUID = strings.ReplaceAll(strings.TrimPrefix(name, "pod"), "_", "-")
+		return true
+	}
+	return false
+}
+
+// ResolveCgroup parses docker, containerd, CRI-O, podman and systemd naming schemes out of a cgroup path.
+func ResolveCgroup(cgroupPath string) ContainerIdentity {
+	var (
+		identity   ContainerIdentity
+		inKubepods bool
+		parent     string
+	)
+	for _, element := range strings.Split(strings.Trim(cgroupPath, "/"), "/") {
+		name, isUnit := trimUnitSuffix(element)
+		if isUnit && !strings.HasSuffix(element, ".slice") {
+			identity.Unit = element
+		}
+		if strings.HasPrefix(name, "kubepods") {
+			inKubepods = true
+		}
+		switch {
+		case inKubepods && parseKubepods(name, &identity):
+		case strings.HasPrefix(name, "crio-conmon-"), strings.HasPrefix(name, "libpod-conmon-"):
+		case isContainerID(name) && parent == "docker":
+			identity.Runtime = RuntimeDocker
+			identity.ContainerID = name
+		case isContainerID(name) && inKubepods:
+			identity.ContainerID = name
+		default:
+			for _, runtime := range runtimePrefixes {
+				if id := strings.TrimPrefix(name, runtime.prefix); id != name && isContainerID(id) {
+					identity.Runtime = runtime.runtime
+					identity.ContainerID = id
+					break
+				}
+			}
+		}
+		parent = name
+	}
+	if identity.PodUID != "" && identity.QoSClass == "" {
+		identity.QoSClass = "guaranteed"
+	}
+	return identity
+}
+
+func cgroupOfPID(pid int) (string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", err
+	}
+	var fallback string
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		switch {
+		case parts[0] == "0" && parts[1] == "":
+			return parts[2], nil
+		case parts[1] == "name=systemd":
+			fallback = parts[2]
+		case fallback == "":
+			fallback = parts[2]
+		}
+	}
+	return fallback, nil
+}
+
+type cachedIdentity struct {
+	startTime uint64
+	identity  ContainerIdentity
+}
+
+// identities remembers the container of every pid seen, a process practically never leaves
+// its container. The start time tells a reused pid apart, pruneIdentities drops exited ones.
+var identities = struct {
+	sync.Mutex
+	byPID map[int]cachedIdentity
+}{byPID: make(map[int]cachedIdentity)}
+
+func processStartTime(pid int) (uint64, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return 0, err
+	}
+	_, fields, err := parseProcStat(string(stat))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(fields[19], 10, 64)
+}
+
+// containerOf resolves the container of a process whose start time is known, from the cache
+// when the pid still belongs to the same process.
+func containerOf(pid int, startTime uint64) ContainerIdentity {
+	identities.Lock()
+	cached, ok := identities.byPID[pid]
+	identities.Unlock()
+	if ok && cached.startTime == startTime {
+		return cached.identity
+	}
+	cgroupPath, err := cgroupOfPID(pid)
+	if err != nil {
+		return ContainerIdentity{}
+	}
+	identity := ResolveCgroup(cgroupPath)
+	identities.Lock()
+	identities.byPID[pid] = cachedIdentity{startTime: startTime, identity: identity}
+	identities.Unlock()
+	return identity
+}
+
+// pruneIdentities forgets the pids that are gone, Collect calls it after every sample.
+func pruneIdentities() {
+	identities.Lock()
+	empty := len(identities.byPID) == 0
+	identities.Unlock()
+	if empty {
+		return
+	}
+	pids, err := listPIDs()
+	if err != nil {
+		return
+	}
+	alive := make(map[int]bool, len(pids))
+	for _, pid := range pids {
+		alive[pid] = true
+	}
+	identities.Lock()
+	defer identities.Unlock()
+	for pid := range identities.byPID {
+		if !alive[pid] {
+			delete(identities.byPID, pid)
+		}
+	}
+}
+
+// ResolvePID resolves the container identity of a process from /proc/<pid>/cgroup. The result
+// is cached for as long as the process lives.
+func ResolvePID(pid int) ContainerIdentity {
+	startTime, err := processStartTime(pid)
+	if err != nil {
+		return ContainerIdentity{}
+	}
+	return containerOf(pid, startTime)
+}
@@ -6,41 +6,76 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
-func CpuStat() (CPUUsage, error) {
-	var objectStat CPUUsage
+// cpuTimes holds the jiffies of the aggregate cpu line of /proc/stat.
+type cpuTimes struct {
+	user, nice, system, idle, iowait, irq, softirq, steal uint64
+}
+
+func (t cpuTimes) total() uint64 {
+	return t.user + t.nice + t.system + t.idle + t.iowait + t.irq + t.softirq + t.steal
+}
+
+func cpuCheck() (cpuTimes, error) {
+	var times cpuTimes
 
 	stat, err := os.Open("/proc/stat")
 	if err != nil {
-		return objectStat, fmt.Errorf("failed to open /proc/stat: %w", err)
+		return times, fmt.Errorf("failed to open /proc/stat: %w", err)
 	}
+	defer stat.Close()
 	scanner := bufio.NewScanner(stat)
 
 	if !scanner.Scan() {
-		err := scanner.Err().Error()
-		return objectStat, fmt.Errorf("failed to read /proc/stat: %w", err)
+		err := scanner.Err()
+		return times, fmt.Errorf("failed to read /proc/stat: %w", err)
 	}
 	parseField := strings.Fields(scanner.Text())
-	userTime, err := strconv.ParseInt(parseField[1], 10, 64)
-	if err != nil {
-		return objectStat, fmt.Errorf("failed to parse /proc/stat: %w", err)
+	if len(parseField) < 5 || parseField[0] != "cpu" {
+		return times, fmt.Errorf("failed to parse /proc/stat: unexpected line %q", scanner.Text())
 	}
-	niceTime, err := strconv.ParseInt(parseField[2], 10, 64)
-	if err != nil {
-		return objectStat, fmt.Errorf("failed to parse /proc/stat: %w", err)
+	// Kernels before 2.6.11 stop after idle, the missing columns stay zero.
+	columns := []*uint64{&times.user, &times.nice, &times.system, &times.idle, &times.iowait, &times.irq, &times.softirq, &times.steal}
+	for i, value := range columns {
+		if i+1 >= len(parseField) {
+			break
+		}
+		*value, err = strconv.ParseUint(parseField[i+1], 10, 64)
+		if err != nil {
+			return times, fmt.Errorf("failed to parse /proc/stat: %w", err)
+		}
 	}
-	systemTime, err := strconv.ParseInt(parseField[3], 10, 64)
+	return times, nil
+}
+
+// CpuStat samples the aggregate cpu line of /proc/stat over one second and returns the share of
+// that second spent in each mode, in percent of all CPUs. User includes nice, system includes
+// hard and soft interrupts and idle includes I/O wait, steal is left out of all three.
+func CpuStat() (CPUUsage, error) {
+	var objectStat CPUUsage
+
+	initValue, err := cpuCheck()
 	if err != nil {
-		return objectStat, fmt.Errorf("failed to parse /proc/stat: %w", err)
+		return objectStat, err
 	}
-	idleTime, err := strconv.ParseInt(parseField[4], 10, 64)
+	time.Sleep(1 * time.Second)
+	deltaValue, err := cpuCheck()
 	if err != nil {
-		return objectStat, fmt.Errorf("failed to parse /proc/stat: %w", err)
+		return objectStat, err
+	}
+
+	total := float64(deltaValue.total()) - float64(initValue.total())
+	if total <= 0 {
+		return objectStat, nil
+	}
+	share := func(prev, cur uint64) float64 {
+		return rate(prev, cur, total) * 100
 	}
 	return CPUUsage{
-		UserMode:   float64(userTime + niceTime),
-		SystemMode: float64(systemTime),
-		Idle:       float64(idleTime),
+		UserMode:   share(initValue.user+initValue.nice, deltaValue.user+deltaValue.nice),
+		SystemMode: share(initValue.system+initValue.irq+initValue.softirq, deltaValue.system+deltaValue.irq+deltaValue.softirq),
+		Idle:       share(initValue.idle+initValue.iowait, deltaValue.idle+deltaValue.iowait),
 	}, nil
 }
@@ -0,0 +1,135 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultFDThreshold = 80
+
+type FDProcess struct {
+	PID          int
+	Command      string
+	OpenFDs      int
+	Limit        uint64
+	UsagePercent float64
+	Container    ContainerIdentity
+}
+
+type FileDescriptorUsage struct {
+	Allocated    uint64
+	Max          uint64
+	UsagePercent float64
+	Inodes       uint64
+	FreeInodes   uint64
+	PIDMax       int
+	Processes    []FDProcess
+}
+
+func readUintFields(file string) ([]uint64, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	fields := strings.Fields(string(data))
+	values := make([]uint64, 0, len(fields))
+	for _, field := range fields {
+		value, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// fdLimit returns the soft RLIMIT_NOFILE of a process, zero when it is unlimited.
+func fdLimit(pid int) (uint64, error) {
+	limits, err := os.Open(fmt.Sprintf("/proc/%d/limits", pid))
+	if err != nil {
+		return 0, err
+	}
+	defer limits.Close()
+
+	scanner := bufio.NewScanner(limits)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "Max open files") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "Max open files"))
+		if len(fields) == 0 || fields[0] == "unlimited" {
+			return 0, nil
+		}
+		return strconv.ParseUint(fields[0], 10, 64)
+	}
+	return 0, fmt.Errorf("no open files limit for %d", pid)
+}
+
+// FileDescriptorStat reports the kernel file and inode tables and lists the processes
+// using more than threshold percent of their open files limit.
+func FileDescriptorStat(threshold float64) (FileDescriptorUsage, error) {
+	var objectFD FileDescriptorUsage
+	if threshold <= 0 {
+		threshold = defaultFDThreshold
+	}
+
+	fileNr, err := readUintFields("/proc/sys/fs/file-nr")
+	if err != nil {
+		return objectFD, err
+	}
+	if len(fileNr) < 3 {
+		return objectFD, fmt.Errorf("failed to parse /proc/sys/fs/file-nr")
+	}
+	objectFD.Allocated = fileNr[0] - fileNr[1]
+	objectFD.Max = fileNr[2]
+	if objectFD.Max > 0 {
+		objectFD.UsagePercent = float64(objectFD.Allocated) / float64(objectFD.Max) * 100
+	}
+	inodeNr, err := readUintFields("/proc/sys/fs/inode-nr")
+	if err != nil {
+		return objectFD, err
+	}
+	if len(inodeNr) >= 2 {
+		objectFD.Inodes = inodeNr[0]
+		objectFD.FreeInodes = inodeNr[1]
+	}
+	if objectFD.PIDMax, err = readKernelLimit("/proc/sys/kernel/pid_max"); err != nil {
+		return objectFD, err
+	}
+
+	pids, err := listPIDs()
+	if err != nil {
+		return objectFD, fmt.Errorf("failed to list /proc: %w", err)
+	}
+	for _, pid := range pids {
+		fds, err := os.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+		if err != nil {
+			continue
+		}
+		limit, err := fdLimit(pid)
+		if err != nil || limit == 0 {
+			continue
+		}
+		usage := float64(len(fds)) / float64(limit) * 100
+		if usage < threshold {
+			continue
+		}
+		objectFD.Processes = append(objectFD.Processes, FDProcess{
+			PID:          pid,
+			Command:      readComm(pid),
+			OpenFDs:      len(fds),
+			Limit:        limit,
+			UsagePercent: usage,
+			Container:    ResolvePID(pid),
+		})
+	}
+	sort.Slice(objectFD.Processes, func(i, j int) bool {
+		return objectFD.Processes[i].UsagePercent > objectFD.Processes[j].UsagePercent
+	})
+	return objectFD, nil
+}
@@ -55,6 +55,7 @@ func FsStat() []FileSystemUsage {
 		}
 		objectFS = append(objectFS, FileSystemUsage{
 			FileSystem:   fs,
+			MountPoint:   mountPoint,
 			UsedMB:       float64(used / MB),
 			UsedPercent:  float64(persentUsed),
 			UsedInode:    float64(inodeUsed),
@@ -0,0 +1,201 @@
+package collector
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+type HostInfo struct {
+	Hostname      string
+	MachineID     string
+	KernelRelease string
+	KernelVersion string
+	Architecture  string
+	OSID          string
+	OSName        string
+	OSVersion     string
+	OSPrettyName  string
+	BootTime      time.Time
+	Uptime        time.Duration
+	CPUModel      string
+	CPUSockets    int
+	CPUCores      int
+	CPUThreads    int
+	MemoryTotal   uint64
+}
+
+// Labels identify the host on every exported sample.
+func (h HostInfo) Labels() map[string]string {
+	return map[string]string{
+		"host":       h.Hostname,
+		"machine_id": h.MachineID,
+		"os":         h.OSID,
+		"os_version": h.OSVersion,
+		"kernel":     h.KernelRelease,
+		"arch":       h.Architecture,
+	}
+}
+
+// utsString takes both the int8 and the uint8 arrays syscall.Utsname uses on different architectures.
+func utsString[T int8 | uint8](field [65]T) string {
+	var b strings.Builder
+	for _, c := range field {
+		if c == 0 {
+			break
+		}
+		b.WriteByte(byte(c))
+	}
+	return b.String()
+}
+
+func readOSRelease(info *HostInfo) error {
+	var (
+		release *os.File
+		err     error
+	)
+	for _, file := range []string{"/etc/os-release", "/usr/lib/os-release"} {
+		if release, err = os.Open(file); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open os-release: %w", err)
+	}
+	defer release.Close()
+
+	scanner := bufio.NewScanner(release)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		} else {
+			value = strings.Trim(value, `'"`)
+		}
+		switch key {
+		case "ID":
+			info.OSID = value
+		case "NAME":
+			info.OSName = value
+		case "VERSION_ID":
+			info.OSVersion = value
+		case "PRETTY_NAME":
+			info.OSPrettyName = value
+		}
+	}
+	return scanner.Err()
+}
+
+func readCPUInfo(info *HostInfo) error {
+	cpuinfo, err := os.Open("/proc/cpuinfo")
+	if err != nil {
+		return fmt.Errorf("failed to open /proc/cpuinfo: %w", err)
+	}
+	defer cpuinfo.Close()
+
+	var physicalID string
+	sockets := make(map[string]bool)
+	cores := make(map[string]bool)
+	scanner := bufio.NewScanner(cpuinfo)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch key {
+		case "processor":
+			info.CPUThreads++
+		case "model name", "Model":
+			if info.CPUModel == "" {
+				info.CPUModel = value
+			}
+		case "physical id":
+			physicalID = value
+			sockets[value] = true
+		case "core id":
+			cores[physicalID+"/"+value] = true
+		}
+	}
+	info.CPUSockets = max(len(sockets), 1)
+	info.CPUCores = len(cores)
+	if info.CPUCores == 0 {
+		info.CPUCores = info.CPUThreads
+	}
+	return scanner.Err()
+}
+
+func readMemTotal() (uint64, error) {
+	meminfo, err := readMeminfo()
+	if err != nil {
+		return 0, err
+	}
+	total, ok := meminfo["MemTotal"]
+	if !ok {
+		return 0, fmt.Errorf("no MemTotal in /proc/meminfo")
+	}
+	return total, nil
+}
+
+func readUptime() (time.Duration, error) {
+	data, err := os.ReadFile("/proc/uptime")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read /proc/uptime: %w", err)
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("failed to parse /proc/uptime")
+	}
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse /proc/uptime: %w", err)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// HostInfoStat gathers the static identity of the host.
+func HostInfoStat() (HostInfo, error) {
+	var info HostInfo
+
+	var uts syscall.Utsname
+	if err := syscall.Uname(&uts); err != nil {
+		return info, fmt.Errorf("uname failed: %w", err)
+	}
+	info.Hostname = utsString(uts.Nodename)
+	info.KernelRelease = utsString(uts.Release)
+	info.KernelVersion = utsString(uts.Version)
+	info.Architecture = utsString(uts.Machine)
+	info.MachineID = readString("/etc/machine-id")
+
+	// Minimal container images ship without os-release, the rest of the inventory still applies.
+	if err := readOSRelease(&info); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return info, err
+	}
+	if err := readCPUInfo(&info); err != nil {
+		return info, err
+	}
+	memTotal, err := readMemTotal()
+	if err != nil {
+		return info, err
+	}
+	info.MemoryTotal = memTotal
+
+	counters, err := kernelCheck()
+	if err != nil {
+		return info, err
+	}
+	info.BootTime = time.Unix(int64(counters.bootTime), 0)
+	if info.Uptime, err = readUptime(); err != nil {
+		return info, err
+	}
+	return info, nil
+}
@@ -0,0 +1,189 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type kernelCounters struct {
+	ctxt         uint64
+	intr         uint64
+	forks        uint64
+	softirq      uint64
+	procsRunning uint64
+	procsBlocked uint64
+	bootTime     uint64
+}
+
+type irqCounter struct {
+	description string
+	count       uint64
+}
+
+func kernelCheck() (kernelCounters, error) {
+	var counters kernelCounters
+
+	stat, err := os.Open("/proc/stat")
+	if err != nil {
+		return counters, fmt.Errorf("failed to open /proc/stat: %w", err)
+	}
+	defer stat.Close()
+
+	fields := map[string]*uint64{
+		"ctxt":          &counters.ctxt,
+		"intr":          &counters.intr,
+		"processes":     &counters.forks,
+		"softirq":       &counters.softirq,
+		"procs_running": &counters.procsRunning,
+		"procs_blocked": &counters.procsBlocked,
+		"btime":         &counters.bootTime,
+	}
+	scanner := bufio.NewScanner(stat)
+	scanner.Buffer(make([]byte, 64*KB), MB)
+	for scanner.Scan() {
+		line := strings.Fields(scanner.Text())
+		if len(line) < 2 {
+			continue
+		}
+		value, ok := fields[line[0]]
+		if !ok {
+			continue
+		}
+		*value, err = strconv.ParseUint(line[1], 10, 64)
+		if err != nil {
+			return counters, fmt.Errorf("failed to parse /proc/stat: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return counters, fmt.Errorf("failed to read /proc/stat: %w", err)
+	}
+	return counters, nil
+}
+
+// irqCheck parses /proc/interrupts and /proc/softirqs, summing the per-CPU columns.
+func irqCheck(file string) (map[string]irqCounter, error) {
+	objectIRQ := make(map[string]irqCounter)
+	irqFile, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file, err)
+	}
+	defer irqFile.Close()
+
+	scanner := bufio.NewScanner(irqFile)
+	scanner.Buffer(make([]byte, 64*KB), MB)
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("failed to read %s: %w", file, scanner.Err())
+	}
+	cpus := len(strings.Fields(scanner.Text()))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		name := strings.TrimSuffix(fields[0], ":")
+		var (
+			count uint64
+			i     = 1
+		)
+		for ; i < len(fields) && i <= cpus; i++ {
+			value, err := strconv.ParseUint(fields[i], 10, 64)
+			if err != nil {
+				break
+			}
+			count += value
+		}
+		objectIRQ[name] = irqCounter{
+			description: strings.Join(fields[i:], " "),
+			count:       count,
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return objectIRQ, nil
+}
+
+func irqRates(initValue, deltaValue map[string]irqCounter, elapsed float64) []Interrupt {
+	stat := make([]Interrupt, 0, len(deltaValue))
+	for name, data := range deltaValue {
+		var perSec float64
+		if prev, ok := initValue[name]; ok && data.count >= prev.count {
+			perSec = float64(data.count-prev.count) / elapsed
+		}
+		stat = append(stat, Interrupt{
+			Name:        name,
+			Description: data.description,
+			Count:       data.count,
+			PerSec:      perSec,
+		})
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		return stat[i].Name < stat[j].Name
+	})
+	return stat
+}
+
+func rate(prev, cur uint64, elapsed float64) float64 {
+	if cur < prev || elapsed <= 0 {
+		return 0
+	}
+	return float64(cur-prev) / elapsed
+}
+
+// KernelStat samples the system-wide counters of /proc/stat over one second.
+// Per-IRQ and per-softirq breakdowns are only collected when interrupts is set.
+func KernelStat(interrupts bool) (KernelActivity, error) {
+	var (
+		objectKernel KernelActivity
+		initIRQ      map[string]irqCounter
+		initSoftIRQ  map[string]irqCounter
+	)
+
+	initValue, err := kernelCheck()
+	if err != nil {
+		return objectKernel, err
+	}
+	if interrupts {
+		if initIRQ, err = irqCheck("/proc/interrupts"); err != nil {
+			return objectKernel, err
+		}
+		if initSoftIRQ, err = irqCheck("/proc/softirqs"); err != nil {
+			return objectKernel, err
+		}
+	}
+	start := time.Now()
+	time.Sleep(1 * time.Second)
+	deltaValue, err := kernelCheck()
+	if err != nil {
+		return objectKernel, err
+	}
+	elapsed := time.Since(start).Seconds()
+
+	objectKernel = KernelActivity{
+		ContextSwitchesPerSec: rate(initValue.ctxt, deltaValue.ctxt, elapsed),
+		InterruptsPerSec:      rate(initValue.intr, deltaValue.intr, elapsed),
+		ForksPerSec:           rate(initValue.forks, deltaValue.forks, elapsed),
+		SoftIRQPerSec:         rate(initValue.softirq, deltaValue.softirq, elapsed),
+		ProcsRunning:          deltaValue.procsRunning,
+		ProcsBlocked:          deltaValue.procsBlocked,
+		BootTime:              deltaValue.bootTime,
+	}
+	if interrupts {
+		deltaIRQ, err := irqCheck("/proc/interrupts")
+		if err != nil {
+			return objectKernel, err
+		}
+		deltaSoftIRQ, err := irqCheck("/proc/softirqs")
+		if err != nil {
+			return objectKernel, err
+		}
+		objectKernel.Interrupts = irqRates(initIRQ, deltaIRQ, elapsed)
+		objectKernel.SoftIRQs = irqRates(initSoftIRQ, deltaSoftIRQ, elapsed)
+	}
+	return objectKernel, nil
+}
@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type MemoryUsage struct {
+	TotalBytes     uint64
+	UsedBytes      uint64
+	FreeBytes      uint64
+	AvailableBytes uint64
+	BuffersBytes   uint64
+	CachedBytes    uint64
+	SwapTotalBytes uint64
+	SwapUsedBytes  uint64
+	UsedPercent    float64
+}
+
+// readMeminfo returns the fields of /proc/meminfo in bytes.
+func readMeminfo() (map[string]uint64, error) {
+	data, err := os.ReadFile("/proc/meminfo")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read /proc/meminfo: %w", err)
+	}
+	meminfo := make(map[string]uint64)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse /proc/meminfo: %w", err)
+		}
+		if len(fields) == 3 && fields[2] == "kB" {
+			value *= KB
+		}
+		meminfo[strings.TrimSuffix(fields[0], ":")] = value
+	}
+	return meminfo, nil
+}
+
+// MemoryStat counts memory the way free(1) does, cache and buffers are not used memory.
+func MemoryStat() (MemoryUsage, error) {
+	meminfo, err := readMeminfo()
+	if err != nil {
+		return MemoryUsage{}, err
+	}
+	usage := MemoryUsage{
+		TotalBytes:     meminfo["MemTotal"],
+		FreeBytes:      meminfo["MemFree"],
+		AvailableBytes: meminfo["MemAvailable"],
+		BuffersBytes:   meminfo["Buffers"],
+		CachedBytes:    meminfo["Cached"] + meminfo["SReclaimable"],
+		SwapTotalBytes: meminfo["SwapTotal"],
+		SwapUsedBytes:  meminfo["SwapTotal"] - meminfo["SwapFree"],
+	}
+	if unused := usage.FreeBytes + usage.BuffersBytes + usage.CachedBytes; unused < usage.TotalBytes {
+		usage.UsedBytes = usage.TotalBytes - unused
+	}
+	if usage.TotalBytes > 0 {
+		usage.UsedPercent = float64(usage.UsedBytes) / float64(usage.TotalBytes) * 100
+	}
+	return usage, nil
+}
@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// NetworkInterface holds the counters of an interface since boot, as /proc/net/dev keeps them.
+type NetworkInterface struct {
+	Name      string
+	RxBytes   uint64
+	RxPackets uint64
+	RxErrors  uint64
+	RxDropped uint64
+	TxBytes   uint64
+	TxPackets uint64
+	TxErrors  uint64
+	TxDropped uint64
+}
+
+// NetDevStat reads the counters of every network interface in /proc/net/dev.
+func NetDevStat() ([]NetworkInterface, error) {
+	file, err := os.Open("/proc/net/dev")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open /proc/net/dev: %w", err)
+	}
+	defer file.Close()
+
+	var interfaces []NetworkInterface
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// The two header lines have no colon after the name.
+		name, counters, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(counters)
+		if len(fields) < 16 {
+			return nil, fmt.Errorf("failed to parse /proc/net/dev: unexpected line %q", scanner.Text())
+		}
+		values := make([]uint64, len(fields))
+		for i, field := range fields {
+			values[i], err = strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse /proc/net/dev: %w", err)
+			}
+		}
+		// Receive columns come first, transmit ones start at the ninth.
+		interfaces = append(interfaces, NetworkInterface{
+			Name:      strings.TrimSpace(name),
+			RxBytes:   values[0],
+			RxPackets: values[1],
+			RxErrors:  values[2],
+			RxDropped: values[3],
+			TxBytes:   values[8],
+			TxPackets: values[9],
+			TxErrors:  values[10],
+			TxDropped: values[11],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read /proc/net/dev: %w", err)
+	}
+	return interfaces, nil
+}
@@ -0,0 +1,168 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const tcpListen = "0A"
+
+type NetworkNamespace struct {
+	Inode       uint64
+	PID         int
+	Host        bool
+	Container   ContainerIdentity
+	Connections []TrafficInfo
+	Listening   []ListeningSocket
+	TCPStates   []TCPStates
+}
+
+// parseNamespaceLink extracts the inode of a "net:[4026531840]" link.
+func parseNamespaceLink(link string) (uint64, error) {
+	start := strings.IndexByte(link, '[')
+	end := strings.IndexByte(link, ']')
+	if start < 0 || end < start {
+		return 0, fmt.Errorf("unexpected namespace link %q", link)
+	}
+	return strconv.ParseUint(link[start+1:end], 10, 64)
+}
+
+func namespaceOf(pid string) (uint64, error) {
+	link, err := os.Readlink(filepath.Join("/proc", pid, "ns", "net"))
+	if err != nil {
+		return 0, err
+	}
+	return parseNamespaceLink(link)
+}
+
+// listNamespaces maps every network namespace to the lowest pid living in it.
+func listNamespaces() (map[uint64]int, error) {
+	pids, err := listPIDs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list /proc: %w", err)
+	}
+	sort.Ints(pids)
+	namespaces := make(map[uint64]int)
+	for _, pid := range pids {
+		inode, err := namespaceOf(strconv.Itoa(pid))
+		if err != nil {
+			continue
+		}
+		if _, ok := namespaces[inode]; !ok {
+			namespaces[inode] = pid
+		}
+	}
+	return namespaces, nil
+}
+
+// socketOwners maps socket inodes to their process with a single pass over every fd table,
+// instead of one pass per socket as findProcessByInode does.
+func socketOwners() map[uint64]int {
+	owners := make(map[uint64]int)
+	pids, err := listPIDs()
+	if err != nil {
+		return owners
+	}
+	for _, pid := range pids {
+		fdPath := fmt.Sprintf("/proc/%d/fd", pid)
+		fds, err := os.ReadDir(fdPath)
+		if err != nil {
+			continue
+		}
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdPath, fd.Name()))
+			if err != nil || !strings.HasPrefix(link, "socket:[") {
+				continue
+			}
+			if inode, err := parseNamespaceLink(link); err == nil {
+				owners[inode] = pid
+			}
+		}
+	}
+	return owners
+}
+
+func namespaceConnections(pid int) []TrafficInfo {
+	var connections []TrafficInfo
+	for _, protocol := range []string{"tcp", "tcp6", "udp", "udp6"} {
+		conns, err := getConnectionInfo(protocol, fmt.Sprintf("/proc/%d/net/%s", pid, protocol))
+		if err != nil {
+			continue
+		}
+		connections = append(connections, conns...)
+	}
+	return connections
+}
+
+// NamespaceStat reads the socket tables of every network namespace through one of its processes.
+func NamespaceStat() ([]NetworkNamespace, error) {
+	namespaces, err := listNamespaces()
+	if err != nil {
+		return nil, err
+	}
+	hostNamespace, _ := namespaceOf("self")
+
+	var (
+		owners map[uint64]int
+		users  = make(map[string]string)
+		stat   = make([]NetworkNamespace, 0, len(namespaces))
+	)
+	for inode, pid := range namespaces {
+		namespace := NetworkNamespace{
+			Inode:     inode,
+			PID:       pid,
+			Host:      inode == hostNamespace,
+			Container: ResolvePID(pid),
+		}
+		states := make(map[string]int)
+		for _, conn := range namespaceConnections(pid) {
+			if strings.HasPrefix(conn.Protocol, "tcp") {
+				states[conn.State]++
+			}
+			if conn.State != tcpListen || !strings.HasPrefix(conn.Protocol, "tcp") {
+				namespace.Connections = append(namespace.Connections, conn)
+				continue
+			}
+			if owners == nil {
+				owners = socketOwners()
+			}
+			socket := ListeningSocket{
+				Command:  unknown,
+				PID:      -1,
+				User:     unknown,
+				Protocol: conn.Protocol,
+				Port:     conn.SourcePort,
+			}
+			if owner, ok := owners[conn.inode]; ok {
+				socket.PID = owner
+				socket.Command = readComm(owner)
+				if uid, err := uidOfPID(owner); err == nil {
+					socket.User = lookupUser(uid, users)
+				}
+				socket.Container = ResolvePID(owner)
+			}
+			namespace.Listening = append(namespace.Listening, socket)
+		}
+		if len(namespace.Connections) > 0 {
+			if owners == nil {
+				owners = socketOwners()
+			}
+			socketContainers(namespace.Connections, owners)
+		}
+		for state, count := range states {
+			namespace.TCPStates = append(namespace.TCPStates, TCPStates{State: state, Count: count})
+		}
+		sort.Slice(namespace.TCPStates, func(i, j int) bool {
+			return namespace.TCPStates[i].State < namespace.TCPStates[j].State
+		})
+		stat = append(stat, namespace)
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		return stat[i].Inode < stat[j].Inode
+	})
+	return stat, nil
+}
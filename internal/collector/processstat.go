@@ -0,0 +1,204 @@
+package collector
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/user"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicks is USER_HZ, which is 100 on every architecture Linux exposes it on.
+const clockTicks = 100
+
+type processCounters struct {
+	pid        int
+	ppid       int
+	command    string
+	cmdline    string
+	state      string
+	uid        string
+	cpuTicks   uint64
+	startTime  uint64
+	rss        uint64
+	swap       uint64
+	readBytes  uint64
+	writeBytes uint64
+	threads    int
+	openFDs    int
+}
+
+func listPIDs() ([]int, error) {
+	d, err := os.Open("/proc")
+	if err != nil {
+		return nil, err
+	}
+	defer d.Close()
+
+	processDirs, err := d.Readdirnames(-1)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int, 0, len(processDirs))
+	for _, pidDir := range processDirs {
+		if pid, err := strconv.Atoi(pidDir); err == nil {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
+
+// parseProcStat splits /proc/<pid>/stat, whose comm field may itself contain spaces and parens.
+func parseProcStat(data string) (string, []string, error) {
+	open := strings.IndexByte(data, '(')
+	closed := strings.LastIndexByte(data, ')')
+	if open < 0 || closed < open {
+		return "", nil, fmt.Errorf("malformed stat line")
+	}
+	fields := strings.Fields(data[closed+1:])
+	if len(fields) < 22 {
+		return "", nil, fmt.Errorf("short stat line")
+	}
+	return data[open+1 : closed], fields, nil
+}
+
+func readProcess(pid int) (processCounters, error) {
+	counters := processCounters{pid: pid}
+
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return counters, err
+	}
+	command, fields, err := parseProcStat(string(stat))
+	if err != nil {
+		return counters, fmt.Errorf("failed to parse /proc/%d/stat: %w", pid, err)
+	}
+	counters.command = command
+	counters.state = fields[0]
+	counters.ppid, _ = strconv.Atoi(fields[1])
+	utime, _ := strconv.ParseUint(fields[11], 10, 64)
+	stime, _ := strconv.ParseUint(fields[12], 10, 64)
+	counters.cpuTicks = utime + stime
+	counters.threads, _ = strconv.Atoi(fields[17])
+	counters.startTime, _ = strconv.ParseUint(fields[19], 10, 64)
+
+	if status, err := os.Open(fmt.Sprintf("/proc/%d/status", pid)); err == nil {
+		scanner := bufio.NewScanner(status)
+		for scanner.Scan() {
+			line := strings.Fields(scanner.Text())
+			if len(line) < 2 {
+				continue
+			}
+			switch line[0] {
+			case "Uid:":
+				counters.uid = line[1]
+			case "VmRSS:":
+				value, _ := strconv.ParseUint(line[1], 10, 64)
+				counters.rss = value * KB
+			case "VmSwap:":
+				value, _ := strconv.ParseUint(line[1], 10, 64)
+				counters.swap = value * KB
+			}
+		}
+		status.Close()
+	}
+
+	if ioFile, err := os.Open(fmt.Sprintf("/proc/%d/io", pid)); err == nil {
+		scanner := bufio.NewScanner(ioFile)
+		for scanner.Scan() {
+			line := strings.Fields(scanner.Text())
+			if len(line) < 2 {
+				continue
+			}
+			switch line[0] {
+			case "read_bytes:":
+				counters.readBytes, _ = strconv.ParseUint(line[1], 10, 64)
+			case "write_bytes:":
+				counters.writeBytes, _ = strconv.ParseUint(line[1], 10, 64)
+			}
+		}
+		ioFile.Close()
+	}
+
+	if cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid)); err == nil {
+		counters.cmdline = strings.TrimSpace(strings.ReplaceAll(string(cmdline), "\x00", " "))
+	}
+	if fds, err := os.ReadDir(fmt.Sprintf("/proc/%d/fd", pid)); err == nil {
+		counters.openFDs = len(fds)
+	}
+	return counters, nil
+}
+
+func processCheck() (map[int]processCounters, error) {
+	pids, err := listPIDs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list /proc: %w", err)
+	}
+	objectProcess := make(map[int]processCounters, len(pids))
+	for _, pid := range pids {
+		counters, err := readProcess(pid)
+		if err != nil {
+			continue
+		}
+		objectProcess[pid] = counters
+	}
+	return objectProcess, nil
+}
+
+func lookupUser(uid string, cache map[string]string) string {
+	if name, ok := cache[uid]; ok {
+		return name
+	}
+	name := unknown
+	if u, err := user.LookupId(uid); err == nil {
+		name = u.Username
+	}
+	cache[uid] = name
+	return name
+}
+
+// ProcessStat samples every process over one second and returns their resource usage.
+func ProcessStat() ([]ProcessUsage, error) {
+	initValue, err := processCheck()
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	time.Sleep(1 * time.Second)
+	deltaValue, err := processCheck()
+	if err != nil {
+		return nil, err
+	}
+	elapsed := time.Since(start).Seconds()
+
+	users := make(map[string]string)
+	stat := make([]ProcessUsage, 0, len(deltaValue))
+	for pid, data := range deltaValue {
+		usage := ProcessUsage{
+			PID:       pid,
+			PPID:      data.ppid,
+			Command:   data.command,
+			Cmdline:   data.cmdline,
+			User:      lookupUser(data.uid, users),
+			State:     data.state,
+			RSSBytes:  data.rss,
+			SwapBytes: data.swap,
+			Threads:   data.threads,
+			OpenFDs:   data.openFDs,
+			Container: containerOf(pid, data.startTime),
+		}
+		if prev, ok := initValue[pid]; ok && prev.startTime == data.startTime {
+			usage.CPUPercent = rate(prev.cpuTicks, data.cpuTicks, elapsed) / clockTicks * 100
+			usage.ReadBytesPerSec = rate(prev.readBytes, data.readBytes, elapsed)
+			usage.WriteBytesPerSec = rate(prev.writeBytes, data.writeBytes, elapsed)
+		}
+		stat = append(stat, usage)
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		return stat[i].PID < stat[j].PID
+	})
+	return stat, nil
+}
@@ -0,0 +1,111 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ZombieProcess struct {
+	PID           int
+	PPID          int
+	Command       string
+	ParentCommand string
+}
+
+type ProcessStates struct {
+	Total               int
+	Running             int
+	Sleeping            int
+	DiskSleep           int
+	Stopped             int
+	Zombie              int
+	Idle                int
+	Threads             int
+	PIDMax              int
+	ThreadsMax          int
+	PIDUsagePercent     float64
+	ThreadsUsagePercent float64
+	Zombies             []ZombieProcess
+}
+
+func readKernelLimit(file string) (int, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+	return limit, nil
+}
+
+// ProcessStateStat counts processes by scheduler state and compares the thread count to the kernel limits.
+// Every thread takes an id from the pid space, so both limits are measured against threads.
+func ProcessStateStat() (ProcessStates, error) {
+	var objectStates ProcessStates
+
+	pids, err := listPIDs()
+	if err != nil {
+		return objectStates, fmt.Errorf("failed to list /proc: %w", err)
+	}
+	commands := make(map[int]string, len(pids))
+	for _, pid := range pids {
+		stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+		if err != nil {
+			continue
+		}
+		command, fields, err := parseProcStat(string(stat))
+		if err != nil {
+			continue
+		}
+		commands[pid] = command
+		objectStates.Total++
+		threads, _ := strconv.Atoi(fields[17])
+		objectStates.Threads += threads
+
+		switch fields[0] {
+		case "R":
+			objectStates.Running++
+		case "S":
+			objectStates.Sleeping++
+		case "D":
+			objectStates.DiskSleep++
+		case "T", "t":
+			objectStates.Stopped++
+		case "I":
+			objectStates.Idle++
+		case "Z":
+			objectStates.Zombie++
+			ppid, _ := strconv.Atoi(fields[1])
+			objectStates.Zombies = append(objectStates.Zombies, ZombieProcess{
+				PID:     pid,
+				PPID:    ppid,
+				Command: command,
+			})
+		}
+	}
+	for i := range objectStates.Zombies {
+		objectStates.Zombies[i].ParentCommand = commands[objectStates.Zombies[i].PPID]
+	}
+	sort.Slice(objectStates.Zombies, func(i, j int) bool {
+		return objectStates.Zombies[i].PID < objectStates.Zombies[j].PID
+	})
+
+	if objectStates.PIDMax, err = readKernelLimit("/proc/sys/kernel/pid_max"); err != nil {
+		return objectStates, err
+	}
+	if objectStates.ThreadsMax, err = readKernelLimit("/proc/sys/kernel/threads-max"); err != nil {
+		return objectStates, err
+	}
+	if objectStates.PIDMax > 0 {
+		objectStates.PIDUsagePercent = float64(objectStates.Threads) / float64(objectStates.PIDMax) * 100
+	}
+	if objectStates.ThreadsMax > 0 {
+		objectStates.ThreadsUsagePercent = float64(objectStates.Threads) / float64(objectStates.ThreadsMax) * 100
+	}
+	return objectStates, nil
+}
@@ -0,0 +1,326 @@
+package collector
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Constants of the netlink process connector, see include/uapi/linux/cn_proc.h.
+const (
+	netlinkConnector   = 11
+	cnIdxProc          = 1
+	cnValProc          = 1
+	procCnMcastListen  = 1
+	procCnMcastIgnore  = 2
+	procEventFork      = 0x00000001
+	procEventExec      = 0x00000002
+	procEventExit      = 0x80000000
+	nlmsgHeaderLen     = 16
+	cnMsgHeaderLen     = 20
+	procEventHeaderLen = 16
+)
+
+const (
+	EventFork = "fork"
+	EventExec = "exec"
+	EventExit = "exit"
+)
+
+type ProcessEvent struct {
+	Time       time.Time
+	Type       string
+	PID        int
+	PPID       int
+	Command    string
+	ExitCode   int
+	ExitSignal int
+}
+
+// ProcessEventCounts are cumulative since the event source started.
+type ProcessEventCounts struct {
+	Time  time.Time
+	Forks uint64
+	Execs uint64
+	Exits uint64
+	// Dropped counts events a slow subscriber missed and socket overruns, an overrun loses an
+	// unknown number of events and counts as one.
+	Dropped uint64
+}
+
+// ProcEvents listens on the netlink proc connector and keeps a bounded history of events.
+type ProcEvents struct {
+	mu          sync.Mutex
+	fd          int
+	history     []ProcessEvent
+	next        int
+	full        bool
+	counts      ProcessEventCounts
+	parents     map[int]int
+	commands    map[int]string
+	subscribers map[chan ProcessEvent]struct{}
+	done        chan struct{}
+}
+
+func NewProcEvents(history int) (*ProcEvents, error) {
+	if history <= 0 {
+		history = 1000
+	}
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, netlinkConnector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open proc connector: %w", err)
+	}
+	// The receive timeout lets Run notice Close without another wakeup.
+	timeout := syscall.NsecToTimeval(time.Second.Nanoseconds())
+	if err := syscall.SetsockoptTimeval(fd, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &timeout); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("failed to configure proc connector: %w", err)
+	}
+	addr := &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK, Groups: cnIdxProc}
+	if err := syscall.Bind(fd, addr); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("failed to bind proc connector: %w", err)
+	}
+	events := &ProcEvents{
+		fd:          fd,
+		history:     make([]ProcessEvent, history),
+		parents:     make(map[int]int),
+		commands:    make(map[int]string),
+		subscribers: make(map[chan ProcessEvent]struct{}),
+		done:        make(chan struct{}),
+	}
+	if err := events.control(procCnMcastListen); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("failed to subscribe to proc connector: %w", err)
+	}
+	return events, nil
+}
+
+func (p *ProcEvents) control(op uint32) error {
+	msg := make([]byte, nlmsgHeaderLen+cnMsgHeaderLen+4)
+	binary.NativeEndian.PutUint32(msg[0:], uint32(len(msg)))
+	binary.NativeEndian.PutUint16(msg[4:], syscall.NLMSG_DONE)
+	binary.NativeEndian.PutUint32(msg[16:], cnIdxProc)
+	binary.NativeEndian.PutUint32(msg[20:], cnValProc)
+	binary.NativeEndian.PutUint16(msg[32:], 4)
+	binary.NativeEndian.PutUint32(msg[36:], op)
+	return syscall.Sendto(p.fd, msg, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK})
+}
+
+// Run reads events until Close is called.
+func (p *ProcEvents) Run() {
+	buf := make([]byte, os.Getpagesize())
+	for {
+		n, _, err := syscall.Recvfrom(p.fd, buf, 0)
+		if err != nil {
+			select {
+			case <-p.done:
+				return
+			default:
+			}
+			if errors.Is(err, syscall.ENOBUFS) {
+				// The kernel ran out of socket buffer and threw events away.
+				p.mu.Lock()
+				p.counts.Dropped++
+				p.mu.Unlock()
+				continue
+			}
+			if errors.Is(err, syscall.EINTR) || errors.Is(err, syscall.EAGAIN) {
+				continue
+			}
+			return
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			continue
+		}
+		for _, msg := range msgs {
+			if event, ok := p.parse(msg.Data); ok {
+				p.record(event)
+			}
+		}
+	}
+}
+
+func (p *ProcEvents) Close() {
+	select {
+	case <-p.done:
+		return
+	default:
+	}
+	close(p.done)
+	_ = p.control(procCnMcastIgnore)
+	syscall.Close(p.fd)
+}
+
+func readComm(pid int) string {
+	comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(comm))
+}
+
+func readPPID(pid int) int {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return -1
+	}
+	_, fields, err := parseProcStat(string(stat))
+	if err != nil {
+		return -1
+	}
+	var ppid int
+	fmt.Sscan(fields[1], &ppid)
+	return ppid
+}
+
+func (p *ProcEvents) parse(data []byte) (ProcessEvent, bool) {
+	var event ProcessEvent
+	if len(data) < cnMsgHeaderLen+procEventHeaderLen {
+		return event, false
+	}
+	header := data[cnMsgHeaderLen:]
+	body := header[procEventHeaderLen:]
+	what := binary.NativeEndian.Uint32(header[0:])
+	event.Time = time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	switch what {
+	case procEventFork:
+		if len(body) < 16 {
+			return event, false
+		}
+		parentTgid := int(binary.NativeEndian.Uint32(body[4:]))
+		childPid := int(binary.NativeEndian.Uint32(body[8:]))
+		childTgid := int(binary.NativeEndian.Uint32(body[12:]))
+		// Threads are reported as forks too, only new thread groups are processes.
+		if childPid != childTgid {
+			return event, false
+		}
+		event.Type = EventFork
+		event.PID = childTgid
+		event.PPID = parentTgid
+		event.Command = p.commands[parentTgid]
+		if event.Command == "" {
+			event.Command = readComm(childTgid)
+		}
+		p.parents[event.PID] = event.PPID
+		p.commands[event.PID] = event.Command
+	case procEventExec:
+		if len(body) < 8 {
+			return event, false
+		}
+		event.Type = EventExec
+		event.PID = int(binary.NativeEndian.Uint32(body[4:]))
+		event.Command = readComm(event.PID)
+		ppid, ok := p.parents[event.PID]
+		if !ok {
+			ppid = readPPID(event.PID)
+		}
+		event.PPID = ppid
+		p.parents[event.PID] = ppid
+		p.commands[event.PID] = event.Command
+	case procEventExit:
+		if len(body) < 16 {
+			return event, false
+		}
+		pid := int(binary.NativeEndian.Uint32(body[0:]))
+		tgid := int(binary.NativeEndian.Uint32(body[4:]))
+		if pid != tgid {
+			return event, false
+		}
+		status := binary.NativeEndian.Uint32(body[8:])
+		event.Type = EventExit
+		event.PID = tgid
+		event.ExitCode = int(status>>8) & 0xff
+		event.ExitSignal = int(status & 0x7f)
+		event.Command = p.commands[tgid]
+		ppid, ok := p.parents[tgid]
+		if !ok && len(body) >= 24 {
+			ppid = int(binary.NativeEndian.Uint32(body[20:]))
+		} else if !ok {
+			ppid = -1
+		}
+		event.PPID = ppid
+		delete(p.parents, tgid)
+		delete(p.commands, tgid)
+	default:
+		return event, false
+	}
+	return event, true
+}
+
+func (p *ProcEvents) record(event ProcessEvent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	switch event.Type {
+	case EventFork:
+		p.counts.Forks++
+	case EventExec:
+		p.counts.Execs++
+	case EventExit:
+		p.counts.Exits++
+	}
+	p.history[p.next] = event
+	p.next = (p.next + 1) % len(p.history)
+	if p.next == 0 {
+		p.full = true
+	}
+	for subscriber := range p.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			p.counts.Dropped++
+		}
+	}
+}
+
+// Counts is safe to call on a nil source, which reports nothing.
+func (p *ProcEvents) Counts() ProcessEventCounts {
+	if p == nil {
+		return ProcessEventCounts{}
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	counts := p.counts
+	counts.Time = time.Now()
+	return counts
+}
+
+// History returns the retained events, oldest first.
+func (p *ProcEvents) History() []ProcessEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.historyLocked()
+}
+
+func (p *ProcEvents) historyLocked() []ProcessEvent {
+	if !p.full {
+		return append([]ProcessEvent(nil), p.history[:p.next]...)
+	}
+	history := make([]ProcessEvent, 0, len(p.history))
+	history = append(history, p.history[p.next:]...)
+	return append(history, p.history[:p.next]...)
+}
+
+// Subscribe returns the retained history and delivers every later event until cancel is called.
+func (p *ProcEvents) Subscribe(buffer int) ([]ProcessEvent, <-chan ProcessEvent, func()) {
+	subscriber := make(chan ProcessEvent, buffer)
+	p.mu.Lock()
+	history := p.historyLocked()
+	p.subscribers[subscriber] = struct{}{}
+	p.mu.Unlock()
+	return history, subscriber, func() {
+		p.mu.Lock()
+		delete(p.subscribers, subscriber)
+		p.mu.Unlock()
+	}
+}
@@ -0,0 +1,167 @@
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	SensorTemperature = "temperature"
+	SensorFan         = "fan"
+	SensorVoltage     = "voltage"
+)
+
+type ThermalZone struct {
+	Zone    string
+	Type    string
+	Celsius float64
+}
+
+// HwmonSensor is one input of a hardware monitor. Chip is the driver name, which several
+// devices can share, Device is the hwmonN directory that tells them apart.
+type HwmonSensor struct {
+	Chip   string
+	Device string
+	Sensor string
+	Label  string
+	Kind   string
+	Value  float64
+}
+
+type CPUFrequency struct {
+	CPU              string
+	CurrentMHz       float64
+	MinMHz           float64
+	MaxMHz           float64
+	CoreThrottles    uint64
+	PackageThrottles uint64
+}
+
+type Sensors struct {
+	ThermalZones []ThermalZone
+	Hwmon        []HwmonSensor
+	CPUFrequency []CPUFrequency
+}
+
+func readString(file string) string {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func readFloat(file string) (float64, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
+}
+
+// ThermalStat reads /sys/class/thermal/thermal_zone*, zones that cannot be read are skipped.
+func ThermalStat() []ThermalZone {
+	zones, _ := filepath.Glob(filepath.Join(SysRoot, "class", "thermal", "thermal_zone*"))
+	stat := make([]ThermalZone, 0, len(zones))
+	for _, zone := range zones {
+		milli, err := readFloat(filepath.Join(zone, "temp"))
+		if err != nil {
+			continue
+		}
+		stat = append(stat, ThermalZone{
+			Zone:    filepath.Base(zone),
+			Type:    readString(filepath.Join(zone, "type")),
+			Celsius: milli / 1000,
+		})
+	}
+	return stat
+}
+
+// HwmonStat reads temperatures, fans and voltages of /sys/class/hwmon/*, converted to
+// degrees Celsius, RPM and volts.
+func HwmonStat() []HwmonSensor {
+	kinds := []struct {
+		prefix string
+		kind   string
+		scale  float64
+	}{
+		{"temp", SensorTemperature, 1000},
+		{"fan", SensorFan, 1},
+		{"in", SensorVoltage, 1000},
+	}
+	chips, _ := filepath.Glob(filepath.Join(SysRoot, "class", "hwmon", "hwmon*"))
+	var stat []HwmonSensor
+	for _, chip := range chips {
+		name := readString(filepath.Join(chip, "name"))
+		if name == "" {
+			name = filepath.Base(chip)
+		}
+		for _, kind := range kinds {
+			inputs, _ := filepath.Glob(filepath.Join(chip, kind.prefix+"*_input"))
+			for _, input := range inputs {
+				value, err := readFloat(input)
+				if err != nil {
+					continue
+				}
+				sensor := strings.TrimSuffix(filepath.Base(input), "_input")
+				stat = append(stat, HwmonSensor{
+					Chip:   name,
+					Device: filepath.Base(chip),
+					Sensor: sensor,
+					Label:  readString(filepath.Join(chip, sensor+"_label")),
+					Kind:   kind.kind,
+					Value:  value / kind.scale,
+				})
+			}
+		}
+	}
+	sort.SliceStable(stat, func(i, j int) bool {
+		if stat[i].Chip != stat[j].Chip {
+			return stat[i].Chip < stat[j].Chip
+		}
+		return stat[i].Device < stat[j].Device
+	})
+	return stat
+}
+
+// CPUFreqStat reads the cpufreq policy and the thermal throttle counters of every CPU.
+func CPUFreqStat() []CPUFrequency {
+	cpus, _ := filepath.Glob(filepath.Join(SysRoot, "devices", "system", "cpu", "cpu[0-9]*"))
+	stat := make([]CPUFrequency, 0, len(cpus))
+	for _, cpu := range cpus {
+		freq := filepath.Join(cpu, "cpufreq")
+		current, err := readFloat(filepath.Join(freq, "scaling_cur_freq"))
+		if err != nil {
+			continue
+		}
+		minFreq, _ := readFloat(filepath.Join(freq, "scaling_min_freq"))
+		maxFreq, _ := readFloat(filepath.Join(freq, "scaling_max_freq"))
+		core, _ := readUint(filepath.Join(cpu, "thermal_throttle", "core_throttle_count"))
+		pkg, _ := readUint(filepath.Join(cpu, "thermal_throttle", "package_throttle_count"))
+		stat = append(stat, CPUFrequency{
+			CPU:              filepath.Base(cpu),
+			CurrentMHz:       current / 1000,
+			MinMHz:           minFreq / 1000,
+			MaxMHz:           maxFreq / 1000,
+			CoreThrottles:    core,
+			PackageThrottles: pkg,
+		})
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		left, _ := strconv.Atoi(strings.TrimPrefix(stat[i].CPU, "cpu"))
+		right, _ := strconv.Atoi(strings.TrimPrefix(stat[j].CPU, "cpu"))
+		return left < right
+	})
+	return stat
+}
+
+func SensorStat() Sensors {
+	return Sensors{
+		ThermalZones: ThermalStat(),
+		Hwmon:        HwmonStat(),
+		CPUFrequency: CPUFreqStat(),
+	}
+}
@@ -0,0 +1,365 @@
+package collector
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	StatsDCounter      = "counter"
+	StatsDGauge        = "gauge"
+	StatsDTimer        = "timer"
+	StatsDHistogram    = "histogram"
+	StatsDDistribution = "distribution"
+	StatsDSet          = "set"
+)
+
+// statsdGaugeExpiry is the number of flush intervals a gauge is kept without an update, so
+// gauges of finished jobs do not hold on to the series limit forever.
+const statsdGaugeExpiry = 30
+
+// statsdMaxSamples bounds the values a timer keeps per interval for its percentiles. Beyond it
+// the kept values are a uniform sample of all values of the interval.
+const statsdMaxSamples = 1024
+
+var statsdTypes = map[string]string{
+	"c":  StatsDCounter,
+	"g":  StatsDGauge,
+	"ms": StatsDTimer,
+	"h":  StatsDHistogram,
+	"d":  StatsDDistribution,
+	"s":  StatsDSet,
+}
+
+// StatsDMetric is one name and tag set aggregated over a flush interval. Counters report the
+// count and its rate per second, gauges the last value, sets the number of unique members and
+// timers, histograms and distributions a summary of the observed values. Count and Sum of a
+// sampled value are corrected by its sample rate, the percentiles are not. A gauge is reported
+// until it goes 30 intervals without an update.
+type StatsDMetric struct {
+	Name  string
+	Type  string
+	Tags  []string
+	Value float64
+	Rate  float64
+	Count uint64
+	Min   float64
+	Max   float64
+	Mean  float64
+	Sum   float64
+	P50   float64
+	P90   float64
+	P95   float64
+	P99   float64
+}
+
+// StatsDMetrics are the aggregates of the last complete interval.
+type StatsDMetrics struct {
+	Metrics []StatsDMetric
+	// Dropped counts the values refused since start because an interval had too many series.
+	Dropped uint64
+}
+
+type statsdAggregate struct {
+	name  string
+	kind  string
+	tags  []string
+	value float64
+	count float64
+	sum   float64
+	min   float64
+	max   float64
+	// seen counts the values of the interval, samples keeps at most statsdMaxSamples of them.
+	seen    int
+	samples []float64
+	members map[string]struct{}
+	// idle counts the flushes since a gauge was last updated.
+	idle int
+}
+
+// StatsD receives StatsD and DogStatsD datagrams on UDP and unix sockets and aggregates them
+// per flush interval.
+type StatsD struct {
+	mu         sync.Mutex
+	interval   time.Duration
+	maxMetrics int
+	conns      []net.PacketConn
+	current    map[string]*statsdAggregate
+	gauges     map[string]*statsdAggregate
+	flushed    []StatsDMetric
+	dropped    uint64
+	done       chan struct{}
+	wg         sync.WaitGroup
+}
+
+// NewStatsD listens on the UDP address and the unix datagram socket that are set. maxMetrics
+// bounds the distinct series of an interval, zero means 10000.
+func NewStatsD(udpAddr, socket string, interval time.Duration, maxMetrics int) (*StatsD, error) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	if maxMetrics <= 0 {
+		maxMetrics = 10000
+	}
+	s := &StatsD{
+		interval:   interval,
+		maxMetrics: maxMetrics,
+		current:    make(map[string]*statsdAggregate),
+		gauges:     make(map[string]*statsdAggregate),
+		done:       make(chan struct{}),
+	}
+	if udpAddr != "" {
+		conn, err := net.ListenPacket("udp", udpAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen on %s: %w", udpAddr, err)
+		}
+		s.conns = append(s.conns, conn)
+	}
+	if socket != "" {
+		// A socket left behind by an earlier run would make the bind fail.
+		if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.closeConns()
+			return nil, fmt.Errorf("failed to remove stale socket %s: %w", socket, err)
+		}
+		conn, err := net.ListenPacket("unixgram", socket)
+		if err != nil {
+			s.closeConns()
+			return nil, fmt.Errorf("failed to listen on %s: %w", socket, err)
+		}
+		s.conns = append(s.conns, conn)
+	}
+	if len(s.conns) == 0 {
+		return nil, errors.New("statsd needs a udp address or a socket to listen on")
+	}
+	return s, nil
+}
+
+// Run reads datagrams and flushes every interval until Close is called.
+func (s *StatsD) Run() {
+	for _, conn := range s.conns {
+		s.wg.Add(1)
+		go s.read(conn)
+	}
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.Flush()
+		}
+	}
+}
+
+func (s *StatsD) read(conn net.PacketConn) {
+	defer s.wg.Done()
+	buf := make([]byte, 65535)
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			s.Ingest(line)
+		}
+	}
+}
+
+func (s *StatsD) closeConns() {
+	for _, conn := range s.conns {
+		conn.Close()
+		if unix, ok := conn.LocalAddr().(*net.UnixAddr); ok {
+			os.Remove(unix.Name)
+		}
+	}
+}
+
+func (s *StatsD) Close() {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	close(s.done)
+	s.closeConns()
+	s.wg.Wait()
+}
+
+// Ingest aggregates one line of the form name:value[:value...]|type[|@rate][|#tag,key:value].
+// Events, service checks and malformed lines are ignored.
+func (s *StatsD) Ingest(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "_e{") || strings.HasPrefix(line, "_sc|") {
+		return
+	}
+	sections := strings.Split(line, "|")
+	name, values, ok := strings.Cut(sections[0], ":")
+	if !ok || name == "" || len(sections) < 2 {
+		return
+	}
+	kind, ok := statsdTypes[sections[1]]
+	if !ok {
+		return
+	}
+	rate := 1.0
+	var tags []string
+	for _, section := range sections[2:] {
+		switch {
+		case strings.HasPrefix(section, "@"):
+			if parsed, err := strconv.ParseFloat(section[1:], 64); err == nil && parsed > 0 && parsed <= 1 {
+				rate = parsed
+			}
+		case strings.HasPrefix(section, "#"):
+			for _, tag := range strings.Split(section[1:], ",") {
+				if tag != "" {
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+	sort.Strings(tags)
+	key := kind + "|" + name + "|" + strings.Join(tags, ",")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, value := range strings.Split(values, ":") {
+		s.add(key, name, kind, tags, value, rate)
+	}
+}
+
+func (s *StatsD) add(key, name, kind string, tags []string, raw string, rate float64) {
+	series := s.current
+	if kind == StatsDGauge {
+		series = s.gauges
+	}
+	agg, ok := series[key]
+	if !ok {
+		if len(s.current)+len(s.gauges) >= s.maxMetrics {
+			s.dropped++
+			return
+		}
+		agg = &statsdAggregate{name: name, kind: kind, tags: tags}
+		if kind == StatsDSet {
+			agg.members = make(map[string]struct{})
+		}
+		series[key] = agg
+	}
+
+	if kind == StatsDSet {
+		agg.members[raw] = struct{}{}
+		return
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	switch kind {
+	case StatsDCounter:
+		agg.value += value / rate
+	case StatsDGauge:
+		agg.idle = 0
+		// A signed gauge value adjusts the gauge instead of replacing it.
+		if raw[0] == '+' || raw[0] == '-' {
+			agg.value += value
+		} else {
+			agg.value = value
+		}
+	default:
+		if agg.seen == 0 || value < agg.min {
+			agg.min = value
+		}
+		if agg.seen == 0 || value > agg.max {
+			agg.max = value
+		}
+		agg.seen++
+		agg.count += 1 / rate
+		agg.sum += value / rate
+		if len(agg.samples) < statsdMaxSamples {
+			agg.samples = append(agg.samples, value)
+		} else if i := rand.Intn(agg.seen); i < statsdMaxSamples {
+			agg.samples[i] = value
+		}
+	}
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	index := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(index, 0)]
+}
+
+// Flush closes the current interval, Run calls it on every tick.
+func (s *StatsD) Flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	metrics := make([]StatsDMetric, 0, len(s.current)+len(s.gauges))
+	seconds := s.interval.Seconds()
+	for key, agg := range s.gauges {
+		agg.idle++
+		if agg.idle > statsdGaugeExpiry {
+			delete(s.gauges, key)
+			continue
+		}
+		metrics = append(metrics, StatsDMetric{Name: agg.name, Type: agg.kind, Tags: agg.tags, Value: agg.value})
+	}
+	for _, agg := range s.current {
+		metric := StatsDMetric{Name: agg.name, Type: agg.kind, Tags: agg.tags}
+		switch agg.kind {
+		case StatsDCounter:
+			metric.Value = agg.value
+			metric.Rate = agg.value / seconds
+			// A counter decremented below zero has no count to report.
+			metric.Count = uint64(max(agg.value, 0))
+		case StatsDSet:
+			metric.Value = float64(len(agg.members))
+		default:
+			if len(agg.samples) == 0 {
+				continue
+			}
+			sort.Float64s(agg.samples)
+			metric.Sum = agg.sum
+			metric.Count = uint64(math.Round(agg.count))
+			metric.Rate = agg.count / seconds
+			metric.Min = agg.min
+			metric.Max = agg.max
+			metric.Mean = agg.sum / agg.count
+			metric.Value = metric.Mean
+			metric.P50 = percentile(agg.samples, 0.5)
+			metric.P90 = percentile(agg.samples, 0.9)
+			metric.P95 = percentile(agg.samples, 0.95)
+			metric.P99 = percentile(agg.samples, 0.99)
+		}
+		metrics = append(metrics, metric)
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		if metrics[i].Name != metrics[j].Name {
+			return metrics[i].Name < metrics[j].Name
+		}
+		return strings.Join(metrics[i].Tags, ",") < strings.Join(metrics[j].Tags, ",")
+	})
+	s.flushed = metrics
+	s.current = make(map[string]*statsdAggregate)
+}
+
+// Snapshot is safe to call on a nil listener, which reports nothing.
+func (s *StatsD) Snapshot() StatsDMetrics {
+	if s == nil {
+		return StatsDMetrics{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return StatsDMetrics{Metrics: s.flushed, Dropped: s.dropped}
+}
@@ -2,7 +2,9 @@ package collector
 
 import (
 	"bufio"
+	"encoding/binary"
 	"fmt"
+	"net"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -23,25 +25,31 @@ type TrafficInfo struct {
 	Bytes      int
 	State      string
 	BPS        float64
+	Container  ContainerIdentity
+	inode      uint64
 }
 
+// parsHex decodes an "ADDR:PORT" pair of /proc/net/*, where the address is stored
+// as native-endian 32-bit words: one for IPv4 and four for IPv6. Addresses are formatted
+// by net.IP, dotted quads for IPv4 and the RFC 5952 form for IPv6.
 func parsHex(hex string) (string, int) {
-	hexIP := hex[:8]
-	hexPort := hex[8:]
-	ip := ""
-	for i := 0; i < 8; i += 2 {
-		octd, err := strconv.ParseInt(hexIP[i:i+2], 16, 32)
+	hexIP, hexPort, ok := strings.Cut(hex, ":")
+	if !ok || (len(hexIP) != 8 && len(hexIP) != 32) {
+		return "", 0
+	}
+	ip := make(net.IP, 0, len(hexIP)/2)
+	for i := 0; i < len(hexIP); i += 8 {
+		word, err := strconv.ParseUint(hexIP[i:i+8], 16, 32)
 		if err != nil {
 			return "", 0
 		}
-		ip = fmt.Sprintf("%s%d.", ip, octd)
+		ip = binary.NativeEndian.AppendUint32(ip, uint32(word))
 	}
-	ip = strings.TrimRight(ip, ".")
 	port, err := strconv.ParseInt(hexPort, 16, 32)
 	if err != nil {
 		return "", 0
 	}
-	return ip, int(port)
+	return ip.String(), int(port)
 }
 
 func getConnectionInfo(protocol, file string) ([]TrafficInfo, error) {
@@ -54,15 +62,15 @@ func getConnectionInfo(protocol, file string) ([]TrafficInfo, error) {
 	scanner := bufio.NewScanner(getInfo)
 	scanner.Scan()
 	for scanner.Scan() {
-		var (
-			sl, localAddr, remAddr, state string
-			txQueue, rxQueue, timer       int
-			retrnsmt, uid, timeout        int
-			inode                         uint64
-		)
-		fmt.Sscan(scanner.Text(), &sl, &localAddr, &remAddr, &state, &txQueue, &rxQueue, &timer, &retrnsmt, &uid, &timeout, &inode)
-		LAddr, LPort := parsHex(localAddr)
-		RAddr, RPort := parsHex(remAddr)
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		LAddr, LPort := parsHex(fields[1])
+		RAddr, RPort := parsHex(fields[2])
+		_, rxHex, _ := strings.Cut(fields[4], ":")
+		rxQueue, _ := strconv.ParseInt(rxHex, 16, 64)
+		inode, _ := strconv.ParseUint(fields[9], 10, 64)
 
 		objectConnection = append(objectConnection, TrafficInfo{
 			SourceIP:   LAddr,
@@ -70,8 +78,9 @@ func getConnectionInfo(protocol, file string) ([]TrafficInfo, error) {
 			DestIP:     RAddr,
 			DestPort:   RPort,
 			Protocol:   protocol,
-			Bytes:      rxQueue,
-			State:      state,
+			Bytes:      int(rxQueue),
+			State:      fields[3],
+			inode:      inode,
 		})
 	}
 	return objectConnection, nil
@@ -119,12 +128,31 @@ func aggregateInfo(m time.Duration) ([]TrafficInfo, map[string]int, map[string]i
 		conn.BPS = float64(conn.Bytes) / float64(time.Second)
 		aggregateSlice = append(aggregateSlice, *conn)
 	}
+	socketContainers(aggregateSlice, socketOwners())
 	sort.Slice(aggregateSlice, func(i, j int) bool {
 		return aggregateSlice[i].BPS > aggregateSlice[j].BPS
 	})
 	return aggregateSlice, protocolBytesMap, protocolStateMap
 }
 
+// socketContainers tags connections with the container of the process owning their socket,
+// resolving every owner once.
+func socketContainers(connections []TrafficInfo, owners map[uint64]int) {
+	identities := make(map[int]ContainerIdentity)
+	for i := range connections {
+		pid, ok := owners[connections[i].inode]
+		if !ok {
+			continue
+		}
+		identity, ok := identities[pid]
+		if !ok {
+			identity = ResolvePID(pid)
+			identities[pid] = identity
+		}
+		connections[i].Container = identity
+	}
+}
+
 func getListeningSockets() ([]ListeningSocket, error) {
 	var listeningSockets []ListeningSocket
 
@@ -153,6 +181,9 @@ func getListeningSockets() ([]ListeningSocket, error) {
 				Protocol: protocol,
 				Port:     socket.Port,
 			}
+			if socket.PID > 0 {
+				ls.Container = ResolvePID(socket.PID)
+			}
 
 			listeningSockets = append(listeningSockets, ls)
 		}
@@ -171,22 +202,10 @@ func getUserFromPID(pid int) (string, error) {
 
 	cmdlineScanner := bufio.NewScanner(cmdlineFile)
 	cmdlineScanner.Scan()
-	uidPath := fmt.Sprintf("/proc/%d/status", pid)
-	statusFile, err := os.Open(uidPath)
+	uidString, err := uidOfPID(pid)
 	if err != nil {
 		return unknown, err
 	}
-	defer statusFile.Close()
-
-	var uidString string
-	statusScanner := bufio.NewScanner(statusFile)
-	for statusScanner.Scan() {
-		text := statusScanner.Text()
-		if strings.HasPrefix(text, "Uid:") {
-			uidString = strings.Fields(text)[1]
-			break
-		}
-	}
 
 	uid, err := strconv.Atoi(uidString)
 	if err != nil {
@@ -201,6 +220,24 @@ func getUserFromPID(pid int) (string, error) {
 	return u.Username, nil
 }
 
+func uidOfPID(pid int) (string, error) {
+	uidPath := fmt.Sprintf("/proc/%d/status", pid)
+	statusFile, err := os.Open(uidPath)
+	if err != nil {
+		return "", err
+	}
+	defer statusFile.Close()
+
+	statusScanner := bufio.NewScanner(statusFile)
+	for statusScanner.Scan() {
+		text := statusScanner.Text()
+		if strings.HasPrefix(text, "Uid:") {
+			return strings.Fields(text)[1], nil
+		}
+	}
+	return "", fmt.Errorf("no Uid in %s", uidPath)
+}
+
 type socketInfo struct {
 	Command string
 	PID     int
@@ -246,43 +283,29 @@ func parseSockets(file string) ([]socketInfo, error) {
 }
 
 func findProcessByInode(inode string) (int, string, error) {
-	fdDir := "/proc"
-	d, err := os.Open(fdDir)
+	pids, err := listPIDs()
 	if err != nil {
 		return -1, "", err
 	}
-	defer d.Close()
 
-	processDirs, err := d.Readdirnames(-1)
-	if err != nil {
-		return -1, "", err
-	}
+	for _, pid := range pids {
+		fdPath := fmt.Sprintf("/proc/%d/fd", pid)
+		fds, err := os.ReadDir(fdPath)
+		if err != nil {
+			continue
+		}
 
-	for _, pidDir := range processDirs {
-		if _, err := strconv.Atoi(pidDir); err == nil {
-			fdPath := fmt.Sprintf("%s/%s/fd", fdDir, pidDir)
-			fds, err := os.ReadDir(fdPath)
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdPath, fd.Name()))
 			if err != nil {
 				continue
 			}
-
-			for _, fd := range fds {
-				link, err := os.Readlink(filepath.Join(fdPath, fd.Name()))
+			if strings.Contains(link, inode) {
+				cmd, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
 				if err != nil {
-					continue
-				}
-				if strings.Contains(link, inode) {
-					cmd, err := os.ReadFile(fmt.Sprintf("/proc/%s/comm", pidDir))
-					if err != nil {
-						return -1, "", err
-					}
-					command := strings.TrimSpace(string(cmd))
-					pid, err := strconv.Atoi(pidDir)
-					if err != nil {
-						return -1, "", err
-					}
-					return pid, command, nil
+					return -1, "", err
 				}
+				return pid, strings.TrimSpace(string(cmd)), nil
 			}
 		}
 	}
@@ -0,0 +1,194 @@
+package collector
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// WatchRule pins a service by exactly one of its comm, a cmdline regexp, a pidfile or a systemd unit cgroup.
+type WatchRule struct {
+	Name    string
+	Comm    string
+	Cmdline string
+	Pidfile string
+	Cgroup  string
+}
+
+type WatchedProcess struct {
+	Name             string
+	Alive            bool
+	PID              int
+	PIDs             []int
+	Restarts         int
+	CPUPercent       float64
+	RSSBytes         uint64
+	SwapBytes        uint64
+	ReadBytesPerSec  float64
+	WriteBytesPerSec float64
+	Threads          int
+	OpenFDs          int
+}
+
+type watchRule struct {
+	WatchRule
+	cmdline *regexp.Regexp
+}
+
+// Watchlist remembers the main PID of every rule between collections to detect restarts.
+type Watchlist struct {
+	mu       sync.Mutex
+	rules    []watchRule
+	mainPID  map[string]int
+	restarts map[string]int
+}
+
+func NewWatchlist(rules []WatchRule) (*Watchlist, error) {
+	watchlist := &Watchlist{
+		mainPID:  make(map[string]int),
+		restarts: make(map[string]int),
+	}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("watch rule without name")
+		}
+		compiled := watchRule{WatchRule: rule}
+		if rule.Cmdline != "" {
+			re, err := regexp.Compile(rule.Cmdline)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cmdline pattern for %s: %w", rule.Name, err)
+			}
+			compiled.cmdline = re
+		}
+		set := 0
+		for _, criterion := range []string{rule.Comm, rule.Cmdline, rule.Pidfile, rule.Cgroup} {
+			if criterion != "" {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("watch rule %s must set exactly one of comm, cmdline, pidfile and cgroup", rule.Name)
+		}
+		watchlist.rules = append(watchlist.rules, compiled)
+	}
+	return watchlist, nil
+}
+
+func (w *Watchlist) Len() int {
+	if w == nil {
+		return 0
+	}
+	return len(w.rules)
+}
+
+func readPidfile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return -1, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+// inCgroup tells whether unit is one of the elements of the cgroup path of pid.
+func inCgroup(pid int, unit string) bool {
+	cgroupPath, err := cgroupOfPID(pid)
+	if err != nil {
+		return false
+	}
+	for _, element := range strings.Split(cgroupPath, "/") {
+		if element == unit {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *watchRule) match(processes []ProcessUsage) []ProcessUsage {
+	var matched []ProcessUsage
+	pidfilePID := -1
+	if r.Pidfile != "" {
+		if pid, err := readPidfile(r.Pidfile); err == nil {
+			pidfilePID = pid
+		}
+	}
+	for _, process := range processes {
+		var ok bool
+		switch {
+		case r.Comm != "":
+			ok = process.Command == r.Comm
+		case r.cmdline != nil:
+			ok = r.cmdline.MatchString(process.Cmdline)
+		case r.Pidfile != "":
+			ok = process.PID == pidfilePID
+		case r.Cgroup != "":
+			ok = inCgroup(process.PID, r.Cgroup)
+		}
+		if ok {
+			matched = append(matched, process)
+		}
+	}
+	return matched
+}
+
+// mainProcess picks the root of the matched process tree, so forked workers do not count as restarts.
+func mainProcess(matched []ProcessUsage) int {
+	pids := make(map[int]bool, len(matched))
+	for _, process := range matched {
+		pids[process.PID] = true
+	}
+	mainPID := -1
+	for _, process := range matched {
+		if pids[process.PPID] {
+			continue
+		}
+		if mainPID == -1 || process.PID < mainPID {
+			mainPID = process.PID
+		}
+	}
+	return mainPID
+}
+
+// Match aggregates the processes of every rule and updates the restart counters.
+func (w *Watchlist) Match(processes []ProcessUsage) []WatchedProcess {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	watched := make([]WatchedProcess, 0, len(w.rules))
+	for i := range w.rules {
+		rule := &w.rules[i]
+		matched := rule.match(processes)
+		object := WatchedProcess{
+			Name:  rule.Name,
+			Alive: len(matched) > 0,
+			PID:   -1,
+		}
+		for _, process := range matched {
+			object.PIDs = append(object.PIDs, process.PID)
+			object.CPUPercent += process.CPUPercent
+			object.RSSBytes += process.RSSBytes
+			object.SwapBytes += process.SwapBytes
+			object.ReadBytesPerSec += process.ReadBytesPerSec
+			object.WriteBytesPerSec += process.WriteBytesPerSec
+			object.Threads += process.Threads
+			object.OpenFDs += process.OpenFDs
+		}
+		sort.Ints(object.PIDs)
+		if object.Alive {
+			object.PID = mainProcess(matched)
+			if prev, ok := w.mainPID[rule.Name]; ok && prev != object.PID {
+				w.restarts[rule.Name]++
+			}
+			w.mainPID[rule.Name] = object.PID
+		}
+		object.Restarts = w.restarts[rule.Name]
+		watched = append(watched, object)
+	}
+	return watched
+}
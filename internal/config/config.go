@@ -11,14 +11,133 @@ type Config struct {
 	Interval time.Duration `yaml:"interval"`
 	Server   struct {
 		Port string `yaml:"port"`
+		// Retention is how many seconds of samples the server keeps for snapshots and stream windows.
+		Retention int `yaml:"retention"`
+		// MinInterval and MaxInterval bound the streaming period clients may ask for, in seconds.
+		MinInterval int `yaml:"minInterval"`
+		MaxInterval int `yaml:"maxInterval"`
 	} `yaml:"server"`
+	Gateway struct {
+		// Listen serves the JSON gateway over HTTP on this address, for example ":8080".
+		Listen string `yaml:"listen"`
+		// Dashboard serves the web dashboard from the root of the gateway.
+		Dashboard bool `yaml:"dashboard"`
+	} `yaml:"gateway"`
 	Metrics struct {
 		EnableLoadAverage     bool `yaml:"enableLoadAverage"`
 		EnableCPU             bool `yaml:"enableCPU"`
+		EnableMemory          bool `yaml:"enableMemory"`
 		EnableDiskUsage       bool `yaml:"enableDiskUsage"`
 		EnableFileSystemUsage bool `yaml:"enableFileSystemUsage"`
 		EnableNetworkProtocol bool `yaml:"enableNetworkProtocol"`
+		EnableKernelActivity  bool `yaml:"enableKernelActivity"`
+		EnableInterrupts      bool `yaml:"enableInterrupts"`
+		EnableProcesses       bool `yaml:"enableProcesses"`
+		EnableProcessEvents   bool `yaml:"enableProcessEvents"`
+		EnableProcessStates   bool `yaml:"enableProcessStates"`
+		EnableCgroups         bool `yaml:"enableCgroups"`
+		EnableNamespaces      bool `yaml:"enableNamespaces"`
+		EnableFileDescriptors bool `yaml:"enableFileDescriptors"`
+		EnableSensors         bool `yaml:"enableSensors"`
+		EnableStatsD          bool `yaml:"enableStatsD"`
 	} `yaml:"metrics"`
+	Processes struct {
+		Top          int    `yaml:"top"`
+		SortBy       string `yaml:"sortBy"`
+		EventHistory int    `yaml:"eventHistory"`
+	} `yaml:"processes"`
+	Cgroups struct {
+		Depth   int      `yaml:"depth"`
+		Include []string `yaml:"include"`
+	} `yaml:"cgroups"`
+	FileDescriptors struct {
+		Threshold float64 `yaml:"threshold"`
+	} `yaml:"fileDescriptors"`
+	StatsD struct {
+		// Listen is the UDP address and Socket the unix datagram socket the listener binds.
+		Listen string `yaml:"listen"`
+		Socket string `yaml:"socket"`
+		// Interval is the flush interval in seconds.
+		Interval   int `yaml:"interval"`
+		MaxMetrics int `yaml:"maxMetrics"`
+	} `yaml:"statsd"`
+	Storage struct {
+		// Path enables the on-disk history when set.
+		Path    string `yaml:"path"`
+		MaxSize int64  `yaml:"maxSize"`
+		// SyncInterval is how often new points reach the disk, zero means "1s" and a negative
+		// interval syncs every point.
+		SyncInterval time.Duration `yaml:"syncInterval"`
+		// Retention keeps each tier for its age, for example "6h" or "720h".
+		Retention struct {
+			Raw         time.Duration `yaml:"raw"`
+			Minute      time.Duration `yaml:"1m"`
+			FiveMinutes time.Duration `yaml:"5m"`
+			Hour        time.Duration `yaml:"1h"`
+		} `yaml:"retention"`
+	} `yaml:"storage"`
+	Exporters struct {
+		Prometheus struct {
+			// Listen enables the HTTP exporter on this address, for example ":9273".
+			Listen string `yaml:"listen"`
+			Path   string `yaml:"path"`
+			// Window averages every scrape over this many seconds of samples.
+			Window int `yaml:"window"`
+		} `yaml:"prometheus"`
+		OTLP struct {
+			// Endpoint enables the OTLP exporter, host:port for grpc and a URL for http.
+			Endpoint string            `yaml:"endpoint"`
+			Protocol string            `yaml:"protocol"`
+			Insecure bool              `yaml:"insecure"`
+			Headers  map[string]string `yaml:"headers"`
+			// Interval pushes an average of this many seconds of samples.
+			Interval  int `yaml:"interval"`
+			QueueSize int `yaml:"queueSize"`
+		} `yaml:"otlp"`
+		Influx struct {
+			// URL enables the InfluxDB exporter, the write API URL or udp://host:port.
+			URL    string `yaml:"url"`
+			Token  string `yaml:"token"`
+			Prefix string `yaml:"prefix"`
+			// Tags renames labels, a label mapped to "" is left out.
+			Tags      map[string]string `yaml:"tags"`
+			BatchSize int               `yaml:"batchSize"`
+			Interval  int               `yaml:"interval"`
+			QueueSize int               `yaml:"queueSize"`
+		} `yaml:"influx"`
+		Graphite struct {
+			// Address enables the Graphite exporter, host:port of the plaintext listener.
+			Address string            `yaml:"address"`
+			Prefix  string            `yaml:"prefix"`
+			Tags    map[string]string `yaml:"tags"`
+			// Tagged writes labels as Graphite tags instead of path nodes.
+			Tagged    bool `yaml:"tagged"`
+			BatchSize int  `yaml:"batchSize"`
+			Interval  int  `yaml:"interval"`
+			QueueSize int  `yaml:"queueSize"`
+		} `yaml:"graphite"`
+		Record struct {
+			// Path enables recording into this directory.
+			Path string `yaml:"path"`
+			// Format is jsonl for whole responses or csv for a file per metric family.
+			Format   string        `yaml:"format"`
+			MaxSize  int64         `yaml:"maxSize"`
+			MaxAge   time.Duration `yaml:"maxAge"`
+			Compress bool          `yaml:"compress"`
+			MaxFiles int           `yaml:"maxFiles"`
+			// Interval records an average of this many seconds of samples.
+			Interval  int `yaml:"interval"`
+			QueueSize int `yaml:"queueSize"`
+		} `yaml:"record"`
+	} `yaml:"exporters"`
+	// Watchlist rules set a name and exactly one of comm, cmdline, pidfile and cgroup.
+	Watchlist []struct {
+		Name    string `yaml:"name"`
+		Comm    string `yaml:"comm"`
+		Cmdline string `yaml:"cmdline"`
+		Pidfile string `yaml:"pidfile"`
+		Cgroup  string `yaml:"cgroup"`
+	} `yaml:"watchlist"`
 }
 
 func LoadConf(path string) (*Config, error) {
@@ -0,0 +1,396 @@
+// Package exporter turns metric responses into named, labelled series for the push and pull
+// exporters.
+package exporter
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+)
+
+const (
+	TypeGauge   = "gauge"
+	TypeCounter = "counter"
+	TypeSummary = "summary"
+
+	namespace = "sysstats_"
+)
+
+// metricName matches what Prometheus does not allow in metric and label names.
+var metricName = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+type Label struct {
+	Name  string
+	Value string
+}
+
+type Sample struct {
+	Labels []Label
+	Value  float64
+	// Suffix is appended to the family name, summaries report their _sum and _count this way.
+	Suffix string
+}
+
+// Family is one metric with its samples. Counter names carry no _total suffix, writers add it.
+type Family struct {
+	Name    string
+	Help    string
+	Unit    string
+	Type    string
+	Samples []Sample
+}
+
+type builder struct {
+	families []*Family
+	byName   map[string]*Family
+	common   []Label
+}
+
+func l(name, value string) Label {
+	return Label{Name: name, Value: value}
+}
+
+func itoa(value int64) string {
+	return strconv.FormatInt(value, 10)
+}
+
+func (b *builder) add(name, metricType, unit, help string, value float64, labels ...Label) *Sample {
+	family, ok := b.byName[name]
+	if !ok {
+		family = &Family{Name: namespace + name, Help: help, Unit: unit, Type: metricType}
+		b.byName[name] = family
+		b.families = append(b.families, family)
+	}
+	family.Samples = append(family.Samples, Sample{
+		Labels: append(append([]Label(nil), labels...), b.common...),
+		Value:  value,
+	})
+	return &family.Samples[len(family.Samples)-1]
+}
+
+func (b *builder) gauge(name, unit, help string, value float64, labels ...Label) {
+	b.add(name, TypeGauge, unit, help, value, labels...)
+}
+
+func (b *builder) counter(name, unit, help string, value float64, labels ...Label) {
+	b.add(name, TypeCounter, unit, help, value, labels...)
+}
+
+// summary adds one series of a summary, a sample per quantile followed by the sum and count.
+func (b *builder) summary(name, unit, help string, quantiles []Sample, sum, count float64, labels ...Label) {
+	for _, quantile := range quantiles {
+		b.add(name, TypeSummary, unit, help, quantile.Value, append(quantile.Labels, labels...)...)
+	}
+	b.add(name, TypeSummary, unit, help, sum, labels...).Suffix = "_sum"
+	b.add(name, TypeSummary, unit, help, count, labels...).Suffix = "_count"
+}
+
+// tagLabels turns DogStatsD tags into labels. A key that is no valid label name, that is
+// reserved or that clashes with a host label gets a tag_ prefix, a repeated key keeps its
+// first value.
+func (b *builder) tagLabels(tags []string) []Label {
+	taken := map[string]bool{"quantile": true}
+	for _, label := range b.common {
+		taken[label.Name] = true
+	}
+	labels := make([]Label, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		key, value, ok := strings.Cut(tag, ":")
+		if !ok {
+			value = "true"
+		}
+		key = metricName.ReplaceAllString(key, "_")
+		if key == "" {
+			continue
+		}
+		if taken[key] || strings.HasPrefix(key, "__") || key[0] >= '0' && key[0] <= '9' {
+			key = "tag_" + key
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		labels = append(labels, l(key, value))
+	}
+	return labels
+}
+
+// Families converts a response into metric families, hostLabels are added to every sample.
+// Percentages become ratios and sizes bytes, following the Prometheus base units.
+func Families(resp *collectorpb.MetricsResponse, hostLabels map[string]string) []Family {
+	b := &builder{byName: make(map[string]*Family)}
+	for name, value := range hostLabels {
+		if value != "" {
+			b.common = append(b.common, l(name, value))
+		}
+	}
+	sort.Slice(b.common, func(i, j int) bool {
+		return b.common[i].Name < b.common[j].Name
+	})
+
+	c := resp.GetCollector()
+	if load := c.GetLoadaverage(); load != nil {
+		b.gauge("load_average", "", "System load average.", load.GetOneMinute(), l("period", "1m"))
+		b.gauge("load_average", "", "System load average.", load.GetFiveMinutes(), l("period", "5m"))
+		b.gauge("load_average", "", "System load average.", load.GetFifteenMinutes(), l("period", "15m"))
+	}
+	if cpu := c.GetCpuusage(); cpu != nil {
+		b.gauge("cpu_usage_ratio", "ratio", "Share of CPU time by mode.", cpu.GetUserMode()/100, l("mode", "user"))
+		b.gauge("cpu_usage_ratio", "ratio", "Share of CPU time by mode.", cpu.GetSystemMode()/100, l("mode", "system"))
+		b.gauge("cpu_usage_ratio", "ratio", "Share of CPU time by mode.", cpu.GetIdle()/100, l("mode", "idle"))
+	}
+	if memory := c.GetMemory(); memory != nil {
+		b.gauge("memory_total_bytes", "bytes", "Total memory.", float64(memory.GetTotalBytes()))
+		for _, state := range []struct {
+			name  string
+			bytes uint64
+		}{
+			{"used", memory.GetUsedBytes()},
+			{"free", memory.GetFreeBytes()},
+			{"buffers", memory.GetBuffersBytes()},
+			{"cached", memory.GetCachedBytes()},
+		} {
+			b.gauge("memory_bytes", "bytes", "Memory by state.", float64(state.bytes), l("state", state.name))
+		}
+		b.gauge("memory_available_bytes", "bytes", "Memory available to new allocations.", float64(memory.GetAvailableBytes()))
+		b.gauge("memory_used_ratio", "ratio", "Used share of the memory.", memory.GetUsedPercent()/100)
+		b.gauge("swap_total_bytes", "bytes", "Total swap space.", float64(memory.GetSwapTotalBytes()))
+		b.gauge("swap_used_bytes", "bytes", "Used swap space.", float64(memory.GetSwapUsedBytes()))
+	}
+	for _, disk := range c.GetDiskusage() {
+		device := l("device", disk.GetName())
+		b.gauge("disk_transfers_per_second", "", "Disk transfers per second.", disk.GetTps(), device)
+		b.gauge("disk_throughput_bytes_per_second", "bytes_per_second", "Disk read and write throughput.", disk.GetKbpersec()*1024, device)
+	}
+	for _, fs := range c.GetFilesystemusage() {
+		labels := []Label{l("device", fs.GetFileSystem()), l("mountpoint", fs.GetMountPoint())}
+		b.gauge("filesystem_used_bytes", "bytes", "Used space of the filesystem.", fs.GetUsedmb()*1024*1024, labels...)
+		b.gauge("filesystem_used_ratio", "ratio", "Used share of the filesystem space.", fs.GetUsedPercent()/100, labels...)
+		b.gauge("filesystem_inodes_used", "", "Used inodes of the filesystem.", fs.GetUsedInode(), labels...)
+		b.gauge("filesystem_inodes_used_ratio", "ratio", "Used share of the filesystem inodes.", fs.GetInodePercent()/100, labels...)
+	}
+	for _, protocol := range c.GetNetworkprotocol() {
+		b.gauge("network_protocol_bytes", "bytes", "Bytes queued on sockets by protocol.", float64(protocol.GetBytes()), l("protocol", protocol.GetProtocol()))
+	}
+	for _, state := range c.GetTcpstates() {
+		b.gauge("tcp_connections", "", "TCP sockets by state.", float64(state.GetCount()), l("state", state.GetState()))
+	}
+	for _, iface := range c.GetNetworkinterface() {
+		for _, direction := range []struct {
+			name                            string
+			bytes, packets, errors, dropped uint64
+		}{
+			{"receive", iface.GetRxBytes(), iface.GetRxPackets(), iface.GetRxErrors(), iface.GetRxDropped()},
+			{"transmit", iface.GetTxBytes(), iface.GetTxPackets(), iface.GetTxErrors(), iface.GetTxDropped()},
+		} {
+			labels := []Label{l("interface", iface.GetName()), l("direction", direction.name)}
+			b.counter("network_bytes", "bytes", "Bytes moved by a network interface.", float64(direction.bytes), labels...)
+			b.counter("network_packets", "", "Packets moved by a network interface.", float64(direction.packets), labels...)
+			b.counter("network_errors", "", "Errors of a network interface.", float64(direction.errors), labels...)
+			b.counter("network_dropped", "", "Packets dropped by a network interface.", float64(direction.dropped), labels...)
+		}
+	}
+	for _, conn := range c.GetTrafficinfo() {
+		b.gauge("connection_queued_bytes", "bytes", "Bytes queued on the socket of a connection, added up over the samples of the window.", float64(conn.GetBytes()),
+			l("protocol", conn.GetProtocol()),
+			l("source", conn.GetSourceip()+":"+itoa(conn.GetSourcePort())),
+			l("destination", conn.GetDestip()+":"+itoa(conn.GetDestPort())),
+			l("state", conn.GetState()))
+	}
+	for _, socket := range c.GetListeningsocket() {
+		b.gauge("listening_socket_info", "", "Listening socket and the process owning it.", 1,
+			l("protocol", socket.GetProtocol()),
+			l("port", itoa(socket.GetPort())),
+			l("process", socket.GetCommand()),
+			l("pid", itoa(socket.GetPid())),
+			l("user", socket.GetUser()))
+	}
+	if kernel := c.GetKernelactivity(); kernel != nil {
+		b.gauge("context_switches_per_second", "", "Context switches per second.", kernel.GetContextSwitches())
+		b.gauge("interrupts_per_second", "", "Interrupts per second.", kernel.GetInterrupts())
+		b.gauge("forks_per_second", "", "Forks per second.", kernel.GetForks())
+		b.gauge("softirqs_per_second", "", "Soft interrupts per second.", kernel.GetSoftirqs())
+		b.gauge("procs_running", "", "Processes in the runnable state.", float64(kernel.GetProcsRunning()))
+		b.gauge("procs_blocked", "", "Processes blocked on I/O.", float64(kernel.GetProcsBlocked()))
+		b.gauge("boot_time_seconds", "seconds", "Boot time as a unix timestamp.", float64(kernel.GetBootTime()))
+		for _, irq := range kernel.GetIrqs() {
+			b.counter("interrupts", "", "Interrupts since boot by line.", float64(irq.GetCount()), l("irq", irq.GetName()), l("description", irq.GetDescription()))
+		}
+		for _, irq := range kernel.GetSoftirq() {
+			b.counter("softirqs", "", "Soft interrupts since boot by type.", float64(irq.GetCount()), l("softirq", irq.GetName()))
+		}
+	}
+	for _, process := range c.GetProcessusage() {
+		labels := []Label{l("pid", itoa(process.GetPid())), l("process", process.GetCommand()), l("user", process.GetUser())}
+		b.gauge("process_cpu_ratio", "ratio", "Share of one CPU used by the process.", process.GetCpuPercent()/100, labels...)
+		b.gauge("process_resident_memory_bytes", "bytes", "Resident memory of the process.", float64(process.GetRssBytes()), labels...)
+		b.gauge("process_swap_bytes", "bytes", "Swapped out memory of the process.", float64(process.GetSwapBytes()), labels...)
+		b.gauge("process_read_bytes_per_second", "bytes_per_second", "Storage reads of the process.", process.GetReadBytesPerSec(), labels...)
+		b.gauge("process_write_bytes_per_second", "bytes_per_second", "Storage writes of the process.", process.GetWriteBytesPerSec(), labels...)
+		b.gauge("process_threads", "", "Threads of the process.", float64(process.GetThreads()), labels...)
+		b.gauge("process_open_fds", "", "Open file descriptors of the process.", float64(process.GetOpenFds()), labels...)
+	}
+	for _, watched := range c.GetWatchedprocess() {
+		name := l("name", watched.GetName())
+		up := 0.0
+		if watched.GetAlive() {
+			up = 1
+		}
+		b.gauge("watched_process_up", "", "Whether a watched process is running.", up, name)
+		b.counter("watched_process_restarts", "", "Restarts of a watched process seen by the daemon.", float64(watched.GetRestarts()), name)
+		b.gauge("watched_process_cpu_ratio", "ratio", "Share of one CPU used by a watched process.", watched.GetCpuPercent()/100, name)
+		b.gauge("watched_process_resident_memory_bytes", "bytes", "Resident memory of a watched process.", float64(watched.GetRssBytes()), name)
+	}
+	if events := c.GetProcessevents(); events != nil {
+		b.gauge("process_events_per_second", "", "Process events per second by type.", events.GetForksPerSecond(), l("type", "fork"))
+		b.gauge("process_events_per_second", "", "Process events per second by type.", events.GetExecsPerSecond(), l("type", "exec"))
+		b.gauge("process_events_per_second", "", "Process events per second by type.", events.GetExitsPerSecond(), l("type", "exit"))
+		b.gauge("process_events_dropped", "", "Process events lost within the window.", float64(events.GetDropped()))
+	}
+	if states := c.GetProcessstates(); states != nil {
+		for _, state := range []struct {
+			name  string
+			count int64
+		}{
+			{"running", states.GetRunning()},
+			{"sleeping", states.GetSleeping()},
+			{"disk_sleep", states.GetDiskSleep()},
+			{"stopped", states.GetStopped()},
+			{"zombie", states.GetZombie()},
+			{"idle", states.GetIdle()},
+		} {
+			b.gauge("processes", "", "Processes by state.", float64(state.count), l("state", state.name))
+		}
+		b.gauge("threads", "", "Threads on the system.", float64(states.GetThreads()))
+		b.gauge("pid_max", "", "Highest process id the kernel hands out.", float64(states.GetPidMax()))
+		b.gauge("threads_max", "", "Thread limit of the kernel.", float64(states.GetThreadsMax()))
+	}
+	for _, cgroup := range c.GetCgroupusage() {
+		path := l("cgroup", cgroup.GetPath())
+		b.gauge("cgroup_cpu_ratio", "ratio", "Share of one CPU used by the cgroup.", cgroup.GetCpuPercent()/100, path)
+		b.gauge("cgroup_cpu_throttled_ratio", "ratio", "Share of periods the cgroup was throttled.", cgroup.GetThrottledPercent()/100, path)
+		b.gauge("cgroup_memory_bytes", "bytes", "Memory charged to the cgroup.", float64(cgroup.GetMemoryBytes()), path)
+		b.gauge("cgroup_read_bytes_per_second", "bytes_per_second", "Storage reads of the cgroup.", cgroup.GetReadBytesPerSec(), path)
+		b.gauge("cgroup_write_bytes_per_second", "bytes_per_second", "Storage writes of the cgroup.", cgroup.GetWriteBytesPerSec(), path)
+		b.gauge("cgroup_pids", "", "Processes in the cgroup.", float64(cgroup.GetPids()), path)
+		for _, pressure := range cgroup.GetPressure() {
+			resource := l("resource", pressure.GetResource())
+			for _, window := range []struct {
+				kind, window string
+				value        float64
+			}{
+				{"some", "10s", pressure.GetSomeAvg10()},
+				{"some", "60s", pressure.GetSomeAvg60()},
+				{"some", "300s", pressure.GetSomeAvg300()},
+				{"full", "10s", pressure.GetFullAvg10()},
+				{"full", "60s", pressure.GetFullAvg60()},
+				{"full", "300s", pressure.GetFullAvg300()},
+			} {
+				b.gauge("cgroup_pressure_ratio", "ratio", "Share of time tasks of the cgroup stalled on a resource.", window.value/100,
+					path, resource, l("kind", window.kind), l("window", window.window))
+			}
+		}
+	}
+	for _, ns := range c.GetNetworknamespace() {
+		netns := l("netns", itoa(ns.GetInode()))
+		b.gauge("netns_connections", "", "Connections in the network namespace.", float64(ns.GetConnections()), netns)
+		for _, state := range ns.GetTcpstates() {
+			b.gauge("netns_tcp_connections", "", "TCP sockets of the network namespace by state.", float64(state.GetCount()), netns, l("state", state.GetState()))
+		}
+	}
+	if fds := c.GetFiledescriptors(); fds != nil {
+		b.gauge("file_descriptors_allocated", "", "Allocated file handles.", float64(fds.GetAllocated()))
+		b.gauge("file_descriptors_max", "", "File handle limit of the kernel.", float64(fds.GetMax()))
+		b.gauge("inodes_allocated", "", "Allocated inodes.", float64(fds.GetInodes()))
+		b.gauge("inodes_free", "", "Free inodes in the inode cache.", float64(fds.GetFreeInodes()))
+		for _, process := range fds.GetProcesses() {
+			b.gauge("process_fd_usage_ratio", "ratio", "Open files of a process against its limit.", process.GetUsagePercent()/100,
+				l("pid", itoa(process.GetPid())), l("process", process.GetCommand()))
+		}
+	}
+	if sensors := c.GetSensors(); sensors != nil {
+		for _, zone := range sensors.GetThermalZones() {
+			b.gauge("thermal_zone_celsius", "celsius", "Temperature of a thermal zone.", zone.GetCelsius(), l("zone", zone.GetZone()), l("type", zone.GetType()))
+		}
+		for _, sensor := range sensors.GetHwmon() {
+			labels := []Label{l("chip", sensor.GetChip()), l("device", sensor.GetDevice()), l("sensor", sensor.GetSensor()), l("label", sensor.GetLabel())}
+			switch sensor.GetKind() {
+			case "temperature":
+				b.gauge("hwmon_temperature_celsius", "celsius", "Temperature reported by a hardware monitor.", sensor.GetValue(), labels...)
+			case "fan":
+				b.gauge("hwmon_fan_rpm", "rpm", "Fan speed reported by a hardware monitor.", sensor.GetValue(), labels...)
+			case "voltage":
+				b.gauge("hwmon_voltage_volts", "volts", "Voltage reported by a hardware monitor.", sensor.GetValue(), labels...)
+			}
+		}
+		for _, cpu := range sensors.GetCpuFrequency() {
+			core := l("cpu", cpu.GetCpu())
+			b.gauge("cpu_frequency_hertz", "hertz", "Current frequency of a CPU.", cpu.GetCurrentMhz()*1e6, core)
+			b.counter("cpu_throttles", "", "Thermal throttle events of a CPU.", float64(cpu.GetCoreThrottles()), core, l("scope", "core"))
+			b.counter("cpu_throttles", "", "Thermal throttle events of a CPU.", float64(cpu.GetPackageThrottles()), core, l("scope", "package"))
+		}
+	}
+
+	if statsd := c.GetStatsd(); statsd != nil {
+		// The type is part of the name, so a counter and a timer called foo do not share a
+		// family. Names that only differ in characters the exporters replace, like a.b and a_b,
+		// still meet: the first one in order keeps the names, the others are left out.
+		owners := make(map[string]string)
+		for _, metric := range statsd.GetMetrics() {
+			name := "statsd_" + metric.GetType() + "_" + metricName.ReplaceAllString(metric.GetName(), "_")
+			series := []string{name}
+			switch metric.GetType() {
+			case collector.StatsDCounter:
+				series = []string{name + "_per_second"}
+			case collector.StatsDGauge, collector.StatsDSet:
+			default:
+				series = append(series, name+"_sum", name+"_count", name+"_per_second")
+			}
+			if !claim(owners, metric.GetName(), series) {
+				continue
+			}
+			labels := b.tagLabels(metric.GetTags())
+			switch metric.GetType() {
+			case collector.StatsDCounter:
+				b.gauge(name+"_per_second", "", "Rate of a StatsD counter.", metric.GetRate(), labels...)
+			case collector.StatsDGauge, collector.StatsDSet:
+				b.gauge(name, "", "StatsD "+metric.GetType()+" value.", metric.GetValue(), labels...)
+			default:
+				quantiles := []Sample{
+					{Labels: []Label{l("quantile", "0.5")}, Value: metric.GetP50()},
+					{Labels: []Label{l("quantile", "0.9")}, Value: metric.GetP90()},
+					{Labels: []Label{l("quantile", "0.95")}, Value: metric.GetP95()},
+					{Labels: []Label{l("quantile", "0.99")}, Value: metric.GetP99()},
+				}
+				b.summary(name, "", "StatsD "+metric.GetType()+" values of the last flush interval.", quantiles, metric.GetSum(), float64(metric.GetCount()), labels...)
+				b.gauge(name+"_per_second", "", "Observations of a StatsD "+metric.GetType()+" per second.", metric.GetRate(), labels...)
+			}
+		}
+		b.counter("statsd_dropped", "", "StatsD values dropped because of the series limit.", float64(statsd.GetDropped()))
+	}
+
+	families := make([]Family, 0, len(b.families))
+	for _, family := range b.families {
+		families = append(families, *family)
+	}
+	return families
+}
+
+// claim reserves the series names for one StatsD metric and fails if another metric already
+// holds one of them.
+func claim(owners map[string]string, owner string, names []string) bool {
+	for _, name := range names {
+		if held, ok := owners[name]; ok && held != owner {
+			return false
+		}
+	}
+	for _, name := range names {
+		owners[name] = owner
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+var (
+	graphiteNode = regexp.MustCompile(`[^A-Za-z0-9_:-]+`)
+	graphiteTag  = strings.NewReplacer(";", "_", "~", "_", "!", "_", "^", "_", " ", "_")
+)
+
+// GraphiteConfig points the Graphite sink at a plaintext TCP listener. Paths start with Prefix.
+// Tagged writes labels as Graphite tags, otherwise their values become path nodes.
+type GraphiteConfig struct {
+	Address string
+	Prefix  string
+	// Tags renames labels, a label mapped to "" is not written.
+	Tags      map[string]string
+	Tagged    bool
+	BatchSize int
+	Timeout   time.Duration
+}
+
+// graphitePath joins sanitized nodes after the prefix, which may hold several nodes itself.
+func graphitePath(prefix string, nodes ...string) string {
+	var path []string
+	for _, node := range append(strings.Split(prefix, "."), nodes...) {
+		if node = strings.Trim(graphiteNode.ReplaceAllString(node, "_"), "_"); node != "" {
+			path = append(path, node)
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+// GraphiteLines formats a response in the Graphite plaintext protocol.
+func GraphiteLines(resp *collectorpb.MetricsResponse, hostLabels map[string]string, cfg GraphiteConfig) []string {
+	timestamp := time.Now()
+	if resp.GetWindowEnd() != nil {
+		timestamp = resp.GetWindowEnd().AsTime()
+	}
+	suffix := " " + strconv.FormatInt(timestamp.Unix(), 10)
+
+	var lines []string
+	for _, family := range Families(resp, hostLabels) {
+		name := strings.TrimPrefix(family.Name, namespace)
+		for _, sample := range family.Samples {
+			if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+				continue
+			}
+			labels := mapLabels(sample.Labels, cfg.Tags)
+
+			var path strings.Builder
+			if cfg.Tagged {
+				path.WriteString(graphitePath(cfg.Prefix, name+sample.Suffix))
+				for _, label := range labels {
+					if label.Value != "" {
+						path.WriteString(";" + graphiteTag.Replace(label.Name) + "=" + graphiteTag.Replace(label.Value))
+					}
+				}
+			} else {
+				nodes := []string{name + sample.Suffix}
+				for _, label := range labels {
+					nodes = append(nodes, label.Value)
+				}
+				path.WriteString(graphitePath(cfg.Prefix, nodes...))
+			}
+			lines = append(lines, path.String()+" "+formatValue(sample.Value)+suffix)
+		}
+	}
+	return lines
+}
+
+type graphiteSink struct {
+	cfg        GraphiteConfig
+	hostLabels map[string]string
+}
+
+// NewGraphiteSink returns a sink writing the plaintext protocol over a fresh TCP connection per push.
+func NewGraphiteSink(cfg GraphiteConfig, hostLabels map[string]string) (Sink, error) {
+	if _, _, err := net.SplitHostPort(cfg.Address); err != nil {
+		return nil, fmt.Errorf("failed to parse graphite address %s: %w", cfg.Address, err)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 1000
+	}
+	return &graphiteSink{cfg: cfg, hostLabels: hostLabels}, nil
+}
+
+func (s *graphiteSink) Send(ctx context.Context, resp *collectorpb.MetricsResponse) error {
+	dialer := net.Dialer{Timeout: s.cfg.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.cfg.Address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to graphite: %w", err)
+	}
+	defer conn.Close()
+
+	// Graphite keeps the last value written for a timestamp, resending on a retry is harmless.
+	for _, batch := range batches(GraphiteLines(resp, s.hostLabels, s.cfg), s.cfg.BatchSize, 0) {
+		conn.SetWriteDeadline(time.Now().Add(s.cfg.Timeout))
+		if _, err := conn.Write([]byte(strings.Join(batch, "\n") + "\n")); err != nil {
+			return fmt.Errorf("failed to write to graphite: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *graphiteSink) Close() error {
+	return nil
+}
@@ -0,0 +1,200 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+// udpPayload keeps datagrams below the usual path MTU.
+const udpPayload = 1400
+
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `, "\n", `\n`)
+	tagEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\n`)
+)
+
+// mapLabels renames labels after mapping, a label mapped to "" is dropped.
+func mapLabels(labels []Label, mapping map[string]string) []Label {
+	mapped := make([]Label, 0, len(labels))
+	for _, label := range labels {
+		if name, ok := mapping[label.Name]; ok {
+			if name == "" {
+				continue
+			}
+			label.Name = name
+		}
+		mapped = append(mapped, label)
+	}
+	return mapped
+}
+
+// batches splits lines into groups of at most size lines and, when maxBytes is set, at most
+// that many bytes. A line longer than maxBytes is sent on its own.
+func batches(lines []string, size, maxBytes int) [][]string {
+	var (
+		result  [][]string
+		current []string
+		length  int
+	)
+	for _, line := range lines {
+		if len(current) > 0 && ((size > 0 && len(current) >= size) || (maxBytes > 0 && length+len(line)+1 > maxBytes)) {
+			result = append(result, current)
+			current, length = nil, 0
+		}
+		current = append(current, line)
+		length += len(line) + 1
+	}
+	if len(current) > 0 {
+		result = append(result, current)
+	}
+	return result
+}
+
+// InfluxConfig points the InfluxDB sink at the HTTP write API or, with a udp:// URL, at a UDP
+// listener. Measurements are named Prefix plus the family name without its namespace.
+type InfluxConfig struct {
+	URL    string
+	Token  string
+	Prefix string
+	// Tags renames labels, a label mapped to "" is not written.
+	Tags      map[string]string
+	BatchSize int
+	Timeout   time.Duration
+}
+
+// InfluxLines formats a response as InfluxDB line protocol, one line per sample with the
+// value in the "value" field.
+func InfluxLines(resp *collectorpb.MetricsResponse, hostLabels map[string]string, cfg InfluxConfig) []string {
+	timestamp := time.Now()
+	if resp.GetWindowEnd() != nil {
+		timestamp = resp.GetWindowEnd().AsTime()
+	}
+
+	var lines []string
+	for _, family := range Families(resp, hostLabels) {
+		name := cfg.Prefix + strings.TrimPrefix(family.Name, namespace)
+		for _, sample := range family.Samples {
+			if math.IsNaN(sample.Value) || math.IsInf(sample.Value, 0) {
+				continue
+			}
+			measurement := measurementEscaper.Replace(name + sample.Suffix)
+			tags := mapLabels(sample.Labels, cfg.Tags)
+			// InfluxDB wants tags sorted by key and rejects empty values.
+			sort.SliceStable(tags, func(i, j int) bool {
+				return tags[i].Name < tags[j].Name
+			})
+			var line strings.Builder
+			line.WriteString(measurement)
+			for _, tag := range tags {
+				if tag.Value == "" {
+					continue
+				}
+				line.WriteString("," + tagEscaper.Replace(tag.Name) + "=" + tagEscaper.Replace(tag.Value))
+			}
+			line.WriteString(" value=" + formatValue(sample.Value) + " " + fmt.Sprint(timestamp.UnixNano()))
+			lines = append(lines, line.String())
+		}
+	}
+	return lines
+}
+
+type influxSink struct {
+	cfg        InfluxConfig
+	hostLabels map[string]string
+	http       *http.Client
+	udp        net.Conn
+}
+
+// NewInfluxSink returns a sink writing line protocol over HTTP or UDP.
+func NewInfluxSink(cfg InfluxConfig, hostLabels map[string]string) (Sink, error) {
+	target, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse influx url %s: %w", cfg.URL, err)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 5000
+	}
+	sink := &influxSink{cfg: cfg, hostLabels: hostLabels}
+
+	switch target.Scheme {
+	case "http", "https":
+		sink.http = &http.Client{Timeout: cfg.Timeout}
+	case "udp":
+		sink.udp, err = net.Dial("udp", target.Host)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dial %s: %w", target.Host, err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown influx url scheme %q", target.Scheme)
+	}
+	return sink, nil
+}
+
+func (s *influxSink) Send(ctx context.Context, resp *collectorpb.MetricsResponse) error {
+	lines := InfluxLines(resp, s.hostLabels, s.cfg)
+	if s.udp != nil {
+		for _, batch := range batches(lines, s.cfg.BatchSize, udpPayload) {
+			if _, err := s.udp.Write([]byte(strings.Join(batch, "\n") + "\n")); err != nil {
+				return fmt.Errorf("failed to write to influx: %w", err)
+			}
+		}
+		return nil
+	}
+
+	// InfluxDB overwrites a point with the same series and timestamp, resending written batches
+	// on a retry is harmless.
+	for _, batch := range batches(lines, s.cfg.BatchSize, 0) {
+		if err := s.write(ctx, batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *influxSink) write(ctx context.Context, lines []string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewBufferString(strings.Join(lines, "\n")+"\n"))
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to build influx request: %w", err))
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if s.cfg.Token != "" {
+		req.Header.Set("Authorization", "Token "+s.cfg.Token)
+	}
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+
+	switch code := resp.StatusCode; {
+	case code >= 200 && code < 300:
+		return nil
+	case code == http.StatusTooManyRequests || code >= 500:
+		return fmt.Errorf("influx returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	default:
+		return Permanent(fmt.Errorf("influx returned %s: %s", resp.Status, strings.TrimSpace(string(body))))
+	}
+}
+
+func (s *influxSink) Close() error {
+	if s.udp != nil {
+		return s.udp.Close()
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	metricsservice "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	otlpScope = "github.com/Gilfoyle3301/system-stats-daemon"
+)
+
+// otlpMetric renames a family after the OpenTelemetry semantic conventions.
+type otlpMetric struct {
+	name string
+	unit string
+	// attributes renames labels, labels missing from it keep their name.
+	attributes map[string]string
+	// extra attributes are added to every point.
+	extra []Label
+	// suffix moves the value of this label into the metric name.
+	suffix string
+	// upDown sends a gauge as a non-monotonic cumulative sum, what the conventions define as an
+	// UpDownCounter.
+	upDown bool
+}
+
+var (
+	filesystemAttributes = map[string]string{"device": "system.device", "mountpoint": "system.filesystem.mountpoint"}
+	networkAttributes    = map[string]string{"interface": "network.interface.name", "direction": "network.io.direction"}
+	processAttributes    = map[string]string{"pid": "process.pid", "process": "process.executable.name", "user": "process.owner"}
+)
+
+var semanticConventions = map[string]otlpMetric{
+	"load_average":                  {name: "system.cpu.load_average", unit: "{thread}", suffix: "period"},
+	"cpu_usage_ratio":               {name: "system.cpu.utilization", unit: "1", attributes: map[string]string{"mode": "cpu.mode"}},
+	"cpu_frequency_hertz":           {name: "system.cpu.frequency", unit: "Hz"},
+	"memory_bytes":                  {name: "system.memory.usage", unit: "By", attributes: map[string]string{"state": "system.memory.state"}, upDown: true},
+	"memory_used_ratio":             {name: "system.memory.utilization", unit: "1"},
+	"swap_used_bytes":               {name: "system.paging.usage", unit: "By", extra: []Label{l("system.paging.state", "used")}, upDown: true},
+	"filesystem_used_bytes":         {name: "system.filesystem.usage", unit: "By", attributes: filesystemAttributes, extra: []Label{l("system.filesystem.state", "used")}, upDown: true},
+	"filesystem_used_ratio":         {name: "system.filesystem.utilization", unit: "1", attributes: filesystemAttributes},
+	"filesystem_inodes_used":        {name: "system.filesystem.inodes.usage", unit: "{inode}", attributes: filesystemAttributes, extra: []Label{l("system.filesystem.state", "used")}, upDown: true},
+	"network_bytes":                 {name: "system.network.io", unit: "By", attributes: networkAttributes},
+	"network_packets":               {name: "system.network.packets", unit: "{packet}", attributes: networkAttributes},
+	"network_errors":                {name: "system.network.errors", unit: "{error}", attributes: networkAttributes},
+	"network_dropped":               {name: "system.network.dropped", unit: "{packet}", attributes: networkAttributes},
+	"tcp_connections":               {name: "system.network.connections", unit: "{connection}", attributes: map[string]string{"state": "system.network.state"}, extra: []Label{l("network.transport", "tcp")}, upDown: true},
+	"processes":                     {name: "system.process.count", unit: "{process}", attributes: map[string]string{"state": "process.status"}, upDown: true},
+	"process_cpu_ratio":             {name: "process.cpu.utilization", unit: "1", attributes: processAttributes},
+	"process_resident_memory_bytes": {name: "process.memory.usage", unit: "By", attributes: processAttributes, upDown: true},
+	"process_threads":               {name: "process.thread.count", unit: "{thread}", attributes: processAttributes, upDown: true},
+	"process_open_fds":              {name: "process.open_file_descriptor.count", unit: "{count}", attributes: processAttributes, upDown: true},
+}
+
+// ucumUnits translates the Prometheus base units to the UCUM codes OTLP expects.
+var ucumUnits = map[string]string{
+	"bytes":            "By",
+	"bytes_per_second": "By/s",
+	"ratio":            "1",
+	"seconds":          "s",
+	"celsius":          "Cel",
+	"hertz":            "Hz",
+	"volts":            "V",
+	"rpm":              "{rotation}/min",
+}
+
+// otlpResource describes the host with the OpenTelemetry resource attributes.
+func otlpResource(host collector.HostInfo) *resourcepb.Resource {
+	resource := &resourcepb.Resource{}
+	for _, attr := range []Label{
+		l("service.name", "system-stats-daemon"),
+		l("host.name", host.Hostname),
+		l("host.id", host.MachineID),
+		l("host.arch", host.Architecture),
+		l("os.type", "linux"),
+		l("os.name", host.OSName),
+		l("os.version", host.OSVersion),
+		l("os.description", host.OSPrettyName),
+	} {
+		if attr.Value != "" {
+			resource.Attributes = append(resource.Attributes, stringAttribute(attr.Name, attr.Value))
+		}
+	}
+	return resource
+}
+
+func stringAttribute(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func attribute(key, value string) *commonpb.KeyValue {
+	if key == "process.pid" {
+		if pid, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: pid}}}
+		}
+	}
+	return stringAttribute(key, value)
+}
+
+// OTLPMetrics converts a response into an OTLP export request. Gauges cover the response window,
+// counters and UpDownCounters are sums from the boot of the host.
+func OTLPMetrics(resp *collectorpb.MetricsResponse, host collector.HostInfo) *metricsservice.ExportMetricsServiceRequest {
+	var (
+		end     = uint64(resp.GetWindowEnd().AsTime().UnixNano())
+		start   = uint64(resp.GetWindowStart().AsTime().UnixNano())
+		boot    = uint64(host.BootTime.UnixNano())
+		metrics []*metricspb.Metric
+		byName  = make(map[string]*metricspb.Metric)
+	)
+	if resp.GetWindowEnd() == nil {
+		end = uint64(time.Now().UnixNano())
+	}
+
+	for _, family := range Families(resp, nil) {
+		short := strings.TrimPrefix(family.Name, namespace)
+		spec, ok := semanticConventions[short]
+		if !ok {
+			spec = otlpMetric{name: "sysstats." + short, unit: ucumUnits[family.Unit]}
+		}
+		if family.Type == TypeSummary {
+			metrics = append(metrics, otlpSummary(spec, family, start, end))
+			continue
+		}
+		for _, sample := range family.Samples {
+			name := spec.name
+			point := &metricspb.NumberDataPoint{
+				StartTimeUnixNano: start,
+				TimeUnixNano:      end,
+				Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: sample.Value},
+			}
+			for _, label := range sample.Labels {
+				if label.Name == spec.suffix {
+					name += "." + label.Value
+					continue
+				}
+				key, ok := spec.attributes[label.Name]
+				if !ok {
+					key = label.Name
+				}
+				point.Attributes = append(point.Attributes, attribute(key, label.Value))
+			}
+			for _, label := range spec.extra {
+				point.Attributes = append(point.Attributes, attribute(label.Name, label.Value))
+			}
+
+			metric, ok := byName[name]
+			if !ok {
+				metric = &metricspb.Metric{Name: name, Description: family.Help, Unit: spec.unit}
+				if family.Type == TypeCounter || spec.upDown {
+					metric.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+						AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+						IsMonotonic:            family.Type == TypeCounter,
+					}}
+				} else {
+					metric.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{}}
+				}
+				byName[name] = metric
+				metrics = append(metrics, metric)
+			}
+			if sum := metric.GetSum(); sum != nil {
+				point.StartTimeUnixNano = boot
+				sum.DataPoints = append(sum.DataPoints, point)
+			} else {
+				metric.GetGauge().DataPoints = append(metric.GetGauge().DataPoints, point)
+			}
+		}
+	}
+	if host.MemoryTotal > 0 {
+		metrics = append(metrics, &metricspb.Metric{
+			Name:        "system.memory.limit",
+			Description: "Total memory of the host.",
+			Unit:        "By",
+			Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: []*metricspb.NumberDataPoint{{
+				TimeUnixNano: end,
+				Value:        &metricspb.NumberDataPoint_AsInt{AsInt: int64(host.MemoryTotal)},
+			}}}},
+		})
+	}
+
+	return &metricsservice.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			Resource: otlpResource(host),
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Scope:   &commonpb.InstrumentationScope{Name: otlpScope},
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
+
+// otlpSummary folds the quantile, sum and count samples of a summary into one data point per
+// label set.
+func otlpSummary(spec otlpMetric, family Family, start, end uint64) *metricspb.Metric {
+	var (
+		summary = &metricspb.Summary{}
+		points  = make(map[string]*metricspb.SummaryDataPoint)
+	)
+	for _, sample := range family.Samples {
+		var (
+			quantile   float64
+			series     strings.Builder
+			attributes []*commonpb.KeyValue
+		)
+		for _, label := range sample.Labels {
+			if label.Name == "quantile" {
+				quantile, _ = strconv.ParseFloat(label.Value, 64)
+				continue
+			}
+			series.WriteString(label.Name + "=" + label.Value + "\x00")
+			attributes = append(attributes, attribute(label.Name, label.Value))
+		}
+		point, ok := points[series.String()]
+		if !ok {
+			point = &metricspb.SummaryDataPoint{StartTimeUnixNano: start, TimeUnixNano: end, Attributes: attributes}
+			points[series.String()] = point
+			summary.DataPoints = append(summary.DataPoints, point)
+		}
+		switch sample.Suffix {
+		case "_sum":
+			point.Sum = sample.Value
+		case "_count":
+			point.Count = uint64(sample.Value)
+		default:
+			point.QuantileValues = append(point.QuantileValues, &metricspb.SummaryDataPoint_ValueAtQuantile{Quantile: quantile, Value: sample.Value})
+		}
+	}
+	return &metricspb.Metric{Name: spec.name, Description: family.Help, Unit: spec.unit, Data: &metricspb.Metric_Summary{Summary: summary}}
+}
+
+// OTLPConfig points the OTLP sink at a collector. Endpoint is host:port for gRPC and a URL
+// for HTTP, where an empty path means /v1/metrics.
+type OTLPConfig struct {
+	Endpoint string
+	Protocol string
+	Insecure bool
+	Headers  map[string]string
+	Timeout  time.Duration
+}
+
+type otlpSink struct {
+	host    collector.HostInfo
+	timeout time.Duration
+	headers map[string]string
+
+	conn   *grpc.ClientConn
+	client metricsservice.MetricsServiceClient
+
+	url  string
+	http *http.Client
+}
+
+// NewOTLPSink returns a sink that exports over OTLP/gRPC or OTLP/HTTP with protobuf bodies.
+func NewOTLPSink(cfg OTLPConfig, host collector.HostInfo) (Sink, error) {
+	sink := &otlpSink{host: host, timeout: cfg.Timeout, headers: cfg.Headers}
+	if sink.timeout <= 0 {
+		sink.timeout = 10 * time.Second
+	}
+
+	switch cfg.Protocol {
+	case ProtocolGRPC, "":
+		creds := credentials.NewTLS(&tls.Config{})
+		if cfg.Insecure {
+			creds = insecure.NewCredentials()
+		}
+		conn, err := grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %w", cfg.Endpoint, err)
+		}
+		sink.conn = conn
+		sink.client = metricsservice.NewMetricsServiceClient(conn)
+	case ProtocolHTTP:
+		endpoint := cfg.Endpoint
+		if !strings.Contains(endpoint, "://") {
+			scheme := "https://"
+			if cfg.Insecure {
+				scheme = "http://"
+			}
+			endpoint = scheme + endpoint
+		}
+		target, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse endpoint %s: %w", cfg.Endpoint, err)
+		}
+		if target.Path == "" || target.Path == "/" {
+			target.Path = "/v1/metrics"
+		}
+		sink.url = target.String()
+		sink.http = &http.Client{}
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol %q", cfg.Protocol)
+	}
+	return sink, nil
+}
+
+func (s *otlpSink) Send(ctx context.Context, resp *collectorpb.MetricsResponse) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	req := OTLPMetrics(resp, s.host)
+	if s.client != nil {
+		return s.sendGRPC(ctx, req)
+	}
+	return s.sendHTTP(ctx, req)
+}
+
+func (s *otlpSink) sendGRPC(ctx context.Context, req *metricsservice.ExportMetricsServiceRequest) error {
+	for key, value := range s.headers {
+		ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+	}
+	reply, err := s.client.Export(ctx, req)
+	if err != nil {
+		// These are the codes the OTLP specification marks as retryable.
+		switch status.Code(err) {
+		case codes.Canceled, codes.DeadlineExceeded, codes.Aborted, codes.OutOfRange,
+			codes.Unavailable, codes.DataLoss, codes.ResourceExhausted:
+			return err
+		}
+		return Permanent(err)
+	}
+	partialSuccess(reply.GetPartialSuccess().GetRejectedDataPoints(), reply.GetPartialSuccess().GetErrorMessage())
+	return nil
+}
+
+func (s *otlpSink) sendHTTP(ctx context.Context, req *metricsservice.ExportMetricsServiceRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to marshal OTLP request: %w", err))
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(fmt.Errorf("failed to build OTLP request: %w", err))
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	for key, value := range s.headers {
+		httpReq.Header.Set(key, value)
+	}
+
+	httpResp, err := s.http.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+	replyBody, err := io.ReadAll(io.LimitReader(httpResp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	switch code := httpResp.StatusCode; {
+	case code >= 200 && code < 300:
+		var reply metricsservice.ExportMetricsServiceResponse
+		if proto.Unmarshal(replyBody, &reply) == nil {
+			partialSuccess(reply.GetPartialSuccess().GetRejectedDataPoints(), reply.GetPartialSuccess().GetErrorMessage())
+		}
+		return nil
+	case code == http.StatusTooManyRequests, code == http.StatusBadGateway,
+		code == http.StatusServiceUnavailable, code == http.StatusGatewayTimeout:
+		return fmt.Errorf("OTLP endpoint returned %s", httpResp.Status)
+	default:
+		return Permanent(fmt.Errorf("OTLP endpoint returned %s", httpResp.Status))
+	}
+}
+
+func partialSuccess(rejected int64, message string) {
+	if rejected > 0 || message != "" {
+		slog.Warn("OTLP endpoint rejected part of the metrics", "rejected", rejected, "message", message)
+	}
+}
+
+func (s *otlpSink) Close() error {
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+const (
+	ContentTypeText        = "text/plain; version=0.0.4; charset=utf-8"
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// Source returns the response an exporter publishes, usually an average over the shared buffer.
+type Source func(ctx context.Context) (*collectorpb.MetricsResponse, error)
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// WritePrometheus writes families in the Prometheus text format, or in OpenMetrics with
+// UNIT lines and the closing EOF marker.
+func WritePrometheus(w io.Writer, families []Family, openMetrics bool) error {
+	out := bufio.NewWriter(w)
+	for _, family := range families {
+		name, sampleName := family.Name, family.Name
+		if family.Type == TypeCounter {
+			sampleName += "_total"
+			if !openMetrics {
+				name = sampleName
+			}
+		}
+		out.WriteString("# HELP " + name + " " + helpEscaper.Replace(family.Help) + "\n")
+		out.WriteString("# TYPE " + name + " " + family.Type + "\n")
+		if openMetrics && family.Unit != "" {
+			out.WriteString("# UNIT " + name + " " + family.Unit + "\n")
+		}
+		for _, sample := range family.Samples {
+			out.WriteString(sampleName + sample.Suffix)
+			if len(sample.Labels) > 0 {
+				out.WriteByte('{')
+				for i, label := range sample.Labels {
+					if i > 0 {
+						out.WriteByte(',')
+					}
+					out.WriteString(label.Name + `="` + labelEscaper.Replace(label.Value) + `"`)
+				}
+				out.WriteByte('}')
+			}
+			out.WriteString(" " + formatValue(sample.Value) + "\n")
+		}
+	}
+	if openMetrics {
+		out.WriteString("# EOF\n")
+	}
+	return out.Flush()
+}
+
+// PrometheusHandler serves the families of source on every scrape, in OpenMetrics when the
+// scraper asks for it.
+func PrometheusHandler(source Source, hostLabels map[string]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, err := source(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+		if openMetrics {
+			w.Header().Set("Content-Type", ContentTypeOpenMetrics)
+		} else {
+			w.Header().Set("Content-Type", ContentTypeText)
+		}
+		WritePrometheus(w, Families(resp, hostLabels), openMetrics)
+	})
+}
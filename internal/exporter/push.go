@@ -0,0 +1,163 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+// Sink delivers responses to a remote system for a Pusher.
+type Sink interface {
+	Send(ctx context.Context, resp *collectorpb.MetricsResponse) error
+	Close() error
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks a send error that retrying cannot fix, the pusher drops the response.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// Backoff spaces the retries of a failed send, doubling from Initial up to Max until
+// MaxElapsed has passed since the first attempt.
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	MaxElapsed time.Duration
+}
+
+var DefaultBackoff = Backoff{
+	Initial:    time.Second,
+	Max:        30 * time.Second,
+	MaxElapsed: 5 * time.Minute,
+}
+
+// Pusher takes a response from the source every interval and hands it to the sink. Responses
+// wait in a bounded queue while the sink retries, the oldest one is dropped when it is full.
+type Pusher struct {
+	Backoff Backoff
+
+	name     string
+	source   Source
+	sink     Sink
+	interval time.Duration
+	queue    chan *collectorpb.MetricsResponse
+}
+
+func NewPusher(name string, source Source, sink Sink, interval time.Duration, queueSize int) *Pusher {
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+	return &Pusher{
+		Backoff:  DefaultBackoff,
+		name:     name,
+		source:   source,
+		sink:     sink,
+		interval: interval,
+		queue:    make(chan *collectorpb.MetricsResponse, queueSize),
+	}
+}
+
+// Run pushes until ctx is done and closes the sink on the way out.
+func (p *Pusher) Run(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.deliver(ctx)
+	}()
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			<-done
+			if err := p.sink.Close(); err != nil {
+				slog.Error("failed to close exporter", "exporter", p.name, "error", err)
+			}
+			return
+		case <-ticker.C:
+		}
+		resp, err := p.source(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				slog.Error("failed to collect metrics for export", "exporter", p.name, "error", err)
+			}
+			continue
+		}
+		p.enqueue(resp)
+	}
+}
+
+func (p *Pusher) enqueue(resp *collectorpb.MetricsResponse) {
+	for {
+		select {
+		case p.queue <- resp:
+			return
+		default:
+		}
+		select {
+		case <-p.queue:
+			slog.Warn("export queue is full, dropping the oldest metrics", "exporter", p.name)
+		default:
+		}
+	}
+}
+
+func (p *Pusher) deliver(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case resp := <-p.queue:
+			if err := p.send(ctx, resp); err != nil && ctx.Err() == nil {
+				slog.Error("failed to export metrics", "exporter", p.name, "error", err)
+			}
+		}
+	}
+}
+
+func (p *Pusher) send(ctx context.Context, resp *collectorpb.MetricsResponse) error {
+	var (
+		start = time.Now()
+		delay = p.Backoff.Initial
+	)
+	for {
+		err := p.sink.Send(ctx, resp)
+		if err == nil || errors.As(err, new(permanentError)) {
+			return err
+		}
+		if time.Since(start)+delay > p.Backoff.MaxElapsed {
+			return err
+		}
+		slog.Warn("export failed, retrying", "exporter", p.name, "error", err, "backoff", delay)
+
+		// Jitter keeps daemons that lost the same collector from retrying in lockstep.
+		timer := time.NewTimer(delay/2 + rand.N(delay/2+1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		delay = min(delay*2, p.Backoff.Max)
+	}
+}
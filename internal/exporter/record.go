@@ -0,0 +1,327 @@
+package exporter
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	FormatJSONLines = "jsonl"
+	FormatCSV       = "csv"
+)
+
+// RecordConfig writes responses into Path. JSON Lines keeps every response whole in
+// metrics.jsonl, CSV writes one file per metric family. A file is rotated when it grows past
+// MaxSize or gets older than MaxAge, Compress gzips rotated files and MaxFiles keeps that many
+// of them per file.
+type RecordConfig struct {
+	Path     string
+	Format   string
+	MaxSize  int64
+	MaxAge   time.Duration
+	Compress bool
+	MaxFiles int
+}
+
+type recordSink struct {
+	cfg   RecordConfig
+	files map[string]*rotatingFile
+}
+
+// NewRecordSink returns a sink that records responses to files.
+func NewRecordSink(cfg RecordConfig) (Sink, error) {
+	switch cfg.Format {
+	case "":
+		cfg.Format = FormatJSONLines
+	case FormatJSONLines, FormatCSV:
+	default:
+		return nil, fmt.Errorf("unknown record format %q", cfg.Format)
+	}
+	if err := os.MkdirAll(cfg.Path, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", cfg.Path, err)
+	}
+	return &recordSink{cfg: cfg, files: make(map[string]*rotatingFile)}, nil
+}
+
+func (s *recordSink) file(name string) *rotatingFile {
+	file, ok := s.files[name]
+	if !ok {
+		file = &rotatingFile{cfg: s.cfg, path: filepath.Join(s.cfg.Path, name)}
+		s.files[name] = file
+	}
+	return file
+}
+
+// Disk errors do not go away by retrying the same response, so they are all permanent.
+func (s *recordSink) Send(_ context.Context, resp *collectorpb.MetricsResponse) error {
+	if s.cfg.Format == FormatJSONLines {
+		line, err := protojson.Marshal(resp)
+		if err != nil {
+			return Permanent(fmt.Errorf("failed to marshal response: %w", err))
+		}
+		return Permanent(s.file("metrics.jsonl").write(append(line, '\n'), nil))
+	}
+
+	timestamp := time.Now()
+	if resp.GetWindowEnd() != nil {
+		timestamp = resp.GetWindowEnd().AsTime()
+	}
+	for _, table := range csvTables(resp.GetCollector()) {
+		var (
+			header = append([]string{"time"}, table.columns...)
+			rows   strings.Builder
+			w      = csv.NewWriter(&rows)
+		)
+		for _, row := range table.rows {
+			w.Write(append([]string{timestamp.UTC().Format(time.RFC3339Nano)}, row...))
+		}
+		w.Flush()
+		if err := s.file(table.name+".csv").write([]byte(rows.String()), header); err != nil {
+			return Permanent(err)
+		}
+	}
+	return nil
+}
+
+func (s *recordSink) Close() error {
+	var errs []error
+	for _, file := range s.files {
+		errs = append(errs, file.close())
+	}
+	return errors.Join(errs...)
+}
+
+type csvTable struct {
+	name    string
+	columns []string
+	rows    [][]string
+}
+
+// csvTables flattens every family of c into a table named after its field. Nested singular
+// messages become dotted columns and repeated scalars are joined with ";". The lists of a
+// singular family get tables of their own, deeper lists are kept as JSON in one column.
+func csvTables(c *collectorpb.Collector) []csvTable {
+	var tables []csvTable
+	message := c.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if !message.Has(field) {
+			continue
+		}
+		name := string(field.Name())
+		if field.IsList() {
+			tables = append(tables, listTable(name, field, message.Get(field).List()))
+			continue
+		}
+
+		family := message.Get(field).Message()
+		nested := family.Descriptor().Fields()
+		if columns := csvColumns(family.Descriptor(), "", true); len(columns) > 0 {
+			tables = append(tables, csvTable{name: name, columns: columns, rows: [][]string{csvRow(family, true)}})
+		}
+		for j := 0; j < nested.Len(); j++ {
+			list := nested.Get(j)
+			if list.IsList() && list.Message() != nil && family.Has(list) {
+				tables = append(tables, listTable(name+"_"+string(list.Name()), list, family.Get(list).List()))
+			}
+		}
+	}
+	return tables
+}
+
+func listTable(name string, field protoreflect.FieldDescriptor, list protoreflect.List) csvTable {
+	table := csvTable{name: name, columns: csvColumns(field.Message(), "", false)}
+	for i := 0; i < list.Len(); i++ {
+		table.rows = append(table.rows, csvRow(list.Get(i).Message(), false))
+	}
+	return table
+}
+
+// csvColumns lists the columns of a message, skipLists leaves out the repeated messages that
+// get their own table.
+func csvColumns(message protoreflect.MessageDescriptor, prefix string, skipLists bool) []string {
+	var columns []string
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := prefix + string(field.Name())
+		switch {
+		case field.IsList() && field.Message() != nil && skipLists:
+		case field.Message() != nil && !field.IsList() && !field.IsMap():
+			columns = append(columns, csvColumns(field.Message(), name+".", false)...)
+		default:
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+func csvRow(message protoreflect.Message, skipLists bool) []string {
+	var row []string
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		switch {
+		case field.IsList() && field.Message() != nil && skipLists:
+		case field.IsList() && field.Message() != nil:
+			list := message.Get(field).List()
+			items := make([]string, 0, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				item, _ := protojson.Marshal(list.Get(j).Message().Interface())
+				items = append(items, string(item))
+			}
+			row = append(row, "["+strings.Join(items, ",")+"]")
+		case field.IsList():
+			list := message.Get(field).List()
+			items := make([]string, 0, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				items = append(items, csvValue(field, list.Get(j)))
+			}
+			row = append(row, strings.Join(items, ";"))
+		case field.Message() != nil && !field.IsMap():
+			row = append(row, csvRow(message.Get(field).Message(), false)...)
+		default:
+			row = append(row, csvValue(field, message.Get(field)))
+		}
+	}
+	return row
+}
+
+func csvValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64)
+	case protoreflect.EnumKind:
+		if enum := field.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return string(enum.Name())
+		}
+	}
+	return value.String()
+}
+
+// rotatingFile appends to path and moves it aside as path-<time> when it is due for rotation.
+type rotatingFile struct {
+	cfg    RecordConfig
+	path   string
+	file   *os.File
+	size   int64
+	opened time.Time
+}
+
+// write appends data, starting a new file with header when the current one was rotated.
+func (f *rotatingFile) write(data []byte, header []string) error {
+	if f.file != nil && ((f.cfg.MaxSize > 0 && f.size+int64(len(data)) > f.cfg.MaxSize) ||
+		(f.cfg.MaxAge > 0 && time.Since(f.opened) > f.cfg.MaxAge)) {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	if f.file == nil {
+		// A file from an earlier run may have other columns, it is rotated instead of appended to.
+		if info, err := os.Stat(f.path); err == nil && info.Size() > 0 {
+			if err := f.rotate(); err != nil {
+				return err
+			}
+		}
+		file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", f.path, err)
+		}
+		f.file, f.size, f.opened = file, 0, time.Now()
+		if header != nil {
+			var line strings.Builder
+			w := csv.NewWriter(&line)
+			w.Write(header)
+			w.Flush()
+			data = append([]byte(line.String()), data...)
+		}
+	}
+	n, err := f.file.Write(data)
+	f.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", f.path, err)
+	}
+	return nil
+}
+
+func (f *rotatingFile) close() error {
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", f.path, err)
+	}
+	ext := filepath.Ext(f.path)
+	base := strings.TrimSuffix(f.path, ext)
+	rotated := base + "-" + time.Now().UTC().Format("20060102T150405.000000") + ext
+	if err := os.Rename(f.path, rotated); err != nil {
+		return fmt.Errorf("failed to rotate %s: %w", f.path, err)
+	}
+	if f.cfg.Compress {
+		if err := compress(rotated); err != nil {
+			return err
+		}
+	}
+	return f.prune(base, ext)
+}
+
+func compress(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s.gz: %w", path, err)
+	}
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		dst.Close()
+		return fmt.Errorf("failed to compress %s: %w", path, err)
+	}
+	if err := errors.Join(gz.Close(), dst.Close()); err != nil {
+		return fmt.Errorf("failed to compress %s: %w", path, err)
+	}
+	return os.Remove(path)
+}
+
+// prune removes the oldest rotated files beyond MaxFiles, their names sort by rotation time.
+func (f *rotatingFile) prune(base, ext string) error {
+	if f.cfg.MaxFiles <= 0 {
+		return nil
+	}
+	rotated, err := filepath.Glob(base + "-*" + ext + "*")
+	if err != nil {
+		return err
+	}
+	sort.Strings(rotated)
+	for len(rotated) > f.cfg.MaxFiles {
+		if err := os.Remove(rotated[0]); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", rotated[0], err)
+		}
+		rotated = rotated[1:]
+	}
+	return nil
+}
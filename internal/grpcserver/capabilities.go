@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fieldUnits covers the fields whose unit does not follow from their name.
+var fieldUnits = map[string]string{
+	"CPUUsage.user_mode":              "percent",
+	"CPUUsage.system_mode":            "percent",
+	"CPUUsage.idle":                   "percent",
+	"DiskUsage.tps":                   "1/s",
+	"DiskUsage.kbpersec":              "KiB/s",
+	"FileSystemUsage.usedmb":          "MiB",
+	"FileSystemUsage.used_inode":      "inodes",
+	"NetworkProtocol.bytes":           "bytes",
+	"TrafficInfo.bps":                 "bytes/s",
+	"TrafficInfo.Bytes":               "bytes",
+	"KernelActivity.context_switches": "1/s",
+	"KernelActivity.interrupts":       "1/s",
+	"KernelActivity.forks":            "1/s",
+	"KernelActivity.softirqs":         "1/s",
+	"KernelActivity.boot_time":        "unix seconds",
+	"CgroupUsage.read_iops":           "1/s",
+	"CgroupUsage.write_iops":          "1/s",
+	"ThermalZone.celsius":             "celsius",
+	"HwmonSensor.value":               "celsius, rpm or volts by kind",
+	"StatsDMetric.rate":               "1/s",
+}
+
+func fieldUnit(message protoreflect.MessageDescriptor, field protoreflect.FieldDescriptor) string {
+	name := string(field.Name())
+	if unit, ok := fieldUnits[string(message.Name())+"."+name]; ok {
+		return unit
+	}
+	switch {
+	case strings.HasSuffix(name, "_percent"):
+		return "percent"
+	case strings.HasSuffix(name, "_bytes_per_sec"):
+		return "bytes/s"
+	case strings.HasSuffix(name, "_per_second"):
+		return "1/s"
+	case strings.HasSuffix(name, "_bytes"), strings.HasPrefix(name, "memory_"):
+		return "bytes"
+	case strings.HasSuffix(name, "_mhz"):
+		return "MHz"
+	}
+	return ""
+}
+
+func fieldInfo(message protoreflect.MessageDescriptor) []*collectorpb.FieldInfo {
+	fields := message.Fields()
+	info := make([]*collectorpb.FieldInfo, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldType := field.Kind().String()
+		if field.Message() != nil {
+			fieldType = string(field.Message().Name())
+		}
+		if field.IsList() {
+			fieldType = "repeated " + fieldType
+		}
+		info = append(info, &collectorpb.FieldInfo{
+			Name: string(field.Name()),
+			Type: fieldType,
+			Unit: fieldUnit(message, field),
+		})
+	}
+	return info
+}
+
+func (s *MetricsCollectorServer) supported(family collectorpb.MetricFamily) bool {
+	if family == collectorpb.MetricFamily_METRIC_FAMILY_PROCESS_EVENTS {
+		// The proc connector needs CAP_NET_ADMIN, a running listener is the only proof.
+		if s.families[family] {
+			return s.options.ProcEvents != nil
+		}
+		return os.Geteuid() == 0
+	}
+	return collector.Supported(collectorFamilies[family])
+}
+
+func (s *MetricsCollectorServer) GetCapabilities(_ context.Context, _ *collectorpb.CapabilitiesRequest) (*collectorpb.Capabilities, error) {
+	collectorFields := (&collectorpb.Collector{}).ProtoReflect().Descriptor().Fields()
+	capabilities := &collectorpb.Capabilities{
+		MinInterval: durationpb.New(s.minInterval),
+		MaxInterval: durationpb.New(s.maxInterval),
+		MaxWindow:   durationpb.New(s.sampler.retention),
+	}
+	for number := range collectorpb.MetricFamily_name {
+		family := collectorpb.MetricFamily(number)
+		if family == collectorpb.MetricFamily_METRIC_FAMILY_UNKNOWN {
+			continue
+		}
+		familyCapability := &collectorpb.FamilyCapability{
+			Family:    family,
+			Enabled:   s.families[family],
+			Supported: s.supported(family),
+		}
+		if field := collectorFields.ByNumber(protoreflect.FieldNumber(number)); field != nil && field.Message() != nil {
+			familyCapability.Fields = fieldInfo(field.Message())
+		}
+		capabilities.Families = append(capabilities.Families, familyCapability)
+	}
+	sort.Slice(capabilities.Families, func(i, j int) bool {
+		return capabilities.Families[i].Family < capabilities.Families[j].Family
+	})
+	return capabilities, nil
+}
@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"github.com/Gilfoyle3301/system-stats-daemon/api/client"
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultKeyframeInterval = 10
+
+// deltaEncoder turns the responses of one stream into keyframes and deltas against the
+// previous message.
+type deltaEncoder struct {
+	interval int
+	sent     int
+	traffic  map[string]*collectorpb.TrafficInfo
+	sockets  map[string]*collectorpb.ListeningSocket
+}
+
+func newDeltaEncoder(interval int) *deltaEncoder {
+	if interval <= 0 {
+		interval = defaultKeyframeInterval
+	}
+	return &deltaEncoder{interval: interval}
+}
+
+// diff returns the entries of current that are new or changed and the keys that disappeared.
+func diff[T proto.Message](previous map[string]T, current []T, key func(T) string) ([]T, []string, map[string]T) {
+	var (
+		upserted []T
+		removed  []string
+		next     = make(map[string]T, len(current))
+	)
+	for _, entry := range current {
+		k := key(entry)
+		next[k] = entry
+		if old, ok := previous[k]; !ok || !proto.Equal(old, entry) {
+			upserted = append(upserted, entry)
+		}
+	}
+	for key := range previous {
+		if _, ok := next[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	return upserted, removed, next
+}
+
+func index[T proto.Message](entries []T, key func(T) string) map[string]T {
+	indexed := make(map[string]T, len(entries))
+	for _, entry := range entries {
+		indexed[key(entry)] = entry
+	}
+	return indexed
+}
+
+func (e *deltaEncoder) encode(resp *collectorpb.MetricsResponse) {
+	collectorData := resp.GetCollector()
+	e.sent++
+	if (e.sent-1)%e.interval == 0 {
+		resp.Keyframe = true
+		e.traffic = index(collectorData.GetTrafficinfo(), client.TrafficKey)
+		e.sockets = index(collectorData.GetListeningsocket(), client.SocketKey)
+		return
+	}
+
+	delta := &collectorpb.CollectorDelta{
+		Trafficinfo:     &collectorpb.TrafficInfoDelta{},
+		Listeningsocket: &collectorpb.ListeningSocketDelta{},
+	}
+	delta.Trafficinfo.Upserted, delta.Trafficinfo.Removed, e.traffic = diff(e.traffic, collectorData.GetTrafficinfo(), client.TrafficKey)
+	delta.Listeningsocket.Upserted, delta.Listeningsocket.Removed, e.sockets = diff(e.sockets, collectorData.GetListeningsocket(), client.SocketKey)
+	collectorData.Trafficinfo = nil
+	collectorData.Listeningsocket = nil
+	resp.Delta = delta
+}
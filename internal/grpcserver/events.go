@@ -0,0 +1,80 @@
+package grpcserver
+
+import (
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var processEventTypes = map[string]collectorpb.ProcessEventType{
+	collector.EventFork: collectorpb.ProcessEventType_PROCESS_EVENT_FORK,
+	collector.EventExec: collectorpb.ProcessEventType_PROCESS_EVENT_EXEC,
+	collector.EventExit: collectorpb.ProcessEventType_PROCESS_EVENT_EXIT,
+}
+
+func (s *MetricsCollectorServer) StreamProcessEvents(req *collectorpb.ProcessEventsRequest, stream collectorpb.MetricsCollector_StreamProcessEventsServer) error {
+	if s.options.ProcEvents == nil {
+		return status.Error(codes.Unavailable, "process events are disabled")
+	}
+	wanted := make(map[collectorpb.ProcessEventType]bool)
+	for _, eventType := range req.GetTypes() {
+		wanted[eventType] = true
+	}
+	send := func(event collector.ProcessEvent) error {
+		eventType := processEventTypes[event.Type]
+		if len(wanted) > 0 && !wanted[eventType] {
+			return nil
+		}
+		return stream.Send(&collectorpb.ProcessEvent{
+			Time:       timestamppb.New(event.Time),
+			Type:       eventType,
+			Pid:        int64(event.PID),
+			Ppid:       int64(event.PPID),
+			Command:    event.Command,
+			ExitCode:   int64(event.ExitCode),
+			ExitSignal: int64(event.ExitSignal),
+		})
+	}
+
+	history, events, cancel := s.options.ProcEvents.Subscribe(1024)
+	defer cancel()
+	if req.GetIncludeHistory() {
+		for _, event := range history {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+	for {
+		select {
+		case event := <-events:
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func averageProcessEvents(dataList []*collector.Collector) *collectorpb.ProcessEventCounts {
+	if len(dataList) == 0 {
+		return &collectorpb.ProcessEventCounts{}
+	}
+	first := dataList[0].ProcessEvents
+	last := dataList[len(dataList)-1].ProcessEvents
+	counts := &collectorpb.ProcessEventCounts{
+		Forks:   int64(last.Forks - first.Forks),
+		Execs:   int64(last.Execs - first.Execs),
+		Exits:   int64(last.Exits - first.Exits),
+		Dropped: int64(last.Dropped - first.Dropped),
+	}
+	if elapsed := last.Time.Sub(first.Time).Seconds(); elapsed > 0 {
+		counts.ForksPerSecond = float64(counts.Forks) / elapsed
+		counts.ExecsPerSecond = float64(counts.Execs) / elapsed
+		counts.ExitsPerSecond = float64(counts.Exits) / elapsed
+	}
+	return counts
+}
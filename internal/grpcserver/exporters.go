@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/config"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/exporter"
+)
+
+// source averages the last window of the shared buffer for an exporter.
+func (s *MetricsCollectorServer) source(window time.Duration) exporter.Source {
+	return func(ctx context.Context) (*collectorpb.MetricsResponse, error) {
+		dataList, err := s.sampler.window(ctx, window)
+		if err != nil {
+			return nil, err
+		}
+		return s.response(dataList, s.defaults), nil
+	}
+}
+
+func (s *MetricsCollectorServer) startExporters(ctx context.Context, cfg *config.Config) {
+	host, err := collector.HostInfoStat()
+	if err != nil {
+		slog.Error(err.Error())
+	}
+	labels := host.Labels()
+
+	if prometheus := cfg.Exporters.Prometheus; prometheus.Listen != "" {
+		path := prometheus.Path
+		if path == "" {
+			path = "/metrics"
+		}
+		mux := http.NewServeMux()
+		mux.Handle(path, exporter.PrometheusHandler(s.source(time.Duration(prometheus.Window)*time.Second), labels))
+		go func() {
+			if err := http.ListenAndServe(prometheus.Listen, mux); err != nil {
+				slog.Error("prometheus exporter stopped", "error", err)
+			}
+		}()
+	}
+
+	if otlp := cfg.Exporters.OTLP; otlp.Endpoint != "" {
+		sink, err := exporter.NewOTLPSink(exporter.OTLPConfig{
+			Endpoint: otlp.Endpoint,
+			Protocol: otlp.Protocol,
+			Insecure: otlp.Insecure,
+			Headers:  otlp.Headers,
+		}, host)
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			s.push(ctx, "otlp", sink, otlp.Interval, otlp.QueueSize)
+		}
+	}
+
+	if influx := cfg.Exporters.Influx; influx.URL != "" {
+		sink, err := exporter.NewInfluxSink(exporter.InfluxConfig{
+			URL:       influx.URL,
+			Token:     influx.Token,
+			Prefix:    influx.Prefix,
+			Tags:      influx.Tags,
+			BatchSize: influx.BatchSize,
+		}, labels)
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			s.push(ctx, "influx", sink, influx.Interval, influx.QueueSize)
+		}
+	}
+
+	if graphite := cfg.Exporters.Graphite; graphite.Address != "" {
+		sink, err := exporter.NewGraphiteSink(exporter.GraphiteConfig{
+			Address:   graphite.Address,
+			Prefix:    graphite.Prefix,
+			Tags:      graphite.Tags,
+			Tagged:    graphite.Tagged,
+			BatchSize: graphite.BatchSize,
+		}, labels)
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			s.push(ctx, "graphite", sink, graphite.Interval, graphite.QueueSize)
+		}
+	}
+
+	if record := cfg.Exporters.Record; record.Path != "" {
+		sink, err := exporter.NewRecordSink(exporter.RecordConfig{
+			Path:     record.Path,
+			Format:   record.Format,
+			MaxSize:  record.MaxSize,
+			MaxAge:   record.MaxAge,
+			Compress: record.Compress,
+			MaxFiles: record.MaxFiles,
+		})
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			s.push(ctx, "record", sink, record.Interval, record.QueueSize)
+		}
+	}
+}
+
+// push sends an average of every interval seconds of samples to sink.
+func (s *MetricsCollectorServer) push(ctx context.Context, name string, sink exporter.Sink, interval, queueSize int) {
+	period := time.Duration(max(interval, 1)) * time.Second
+	go exporter.NewPusher(name, s.source(period), sink, period, queueSize).Run(ctx)
+}
@@ -0,0 +1,217 @@
+package grpcserver
+
+import (
+	"sort"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type familySet map[collectorpb.MetricFamily]bool
+
+// selection is what a single request asked for.
+type selection struct {
+	families       familySet
+	processSort    collectorpb.ProcessSortKey
+	topProcesses   int
+	topConnections int
+}
+
+// collectorFamilies maps every metric family to the collector producing it.
+var collectorFamilies = map[collectorpb.MetricFamily]string{
+	collectorpb.MetricFamily_METRIC_FAMILY_LOAD_AVERAGE:       collector.FamilyLoadAverage,
+	collectorpb.MetricFamily_METRIC_FAMILY_CPU:                collector.FamilyCPU,
+	collectorpb.MetricFamily_METRIC_FAMILY_DISK:               collector.FamilyDisk,
+	collectorpb.MetricFamily_METRIC_FAMILY_FILESYSTEM:         collector.FamilyFileSystems,
+	collectorpb.MetricFamily_METRIC_FAMILY_NETWORK_PROTOCOLS:  collector.FamilyNetwork,
+	collectorpb.MetricFamily_METRIC_FAMILY_CONNECTIONS:        collector.FamilyNetwork,
+	collectorpb.MetricFamily_METRIC_FAMILY_TCP_STATES:         collector.FamilyNetwork,
+	collectorpb.MetricFamily_METRIC_FAMILY_LISTENING_SOCKETS:  collector.FamilyNetwork,
+	collectorpb.MetricFamily_METRIC_FAMILY_KERNEL_ACTIVITY:    collector.FamilyKernelActivity,
+	collectorpb.MetricFamily_METRIC_FAMILY_PROCESSES:          collector.FamilyProcesses,
+	collectorpb.MetricFamily_METRIC_FAMILY_WATCHLIST:          collector.FamilyProcesses,
+	collectorpb.MetricFamily_METRIC_FAMILY_PROCESS_STATES:     collector.FamilyProcessStates,
+	collectorpb.MetricFamily_METRIC_FAMILY_CGROUPS:            collector.FamilyCgroups,
+	collectorpb.MetricFamily_METRIC_FAMILY_NAMESPACES:         collector.FamilyNamespaces,
+	collectorpb.MetricFamily_METRIC_FAMILY_FILE_DESCRIPTORS:   collector.FamilyFileDescriptors,
+	collectorpb.MetricFamily_METRIC_FAMILY_SENSORS:            collector.FamilySensors,
+	collectorpb.MetricFamily_METRIC_FAMILY_STATSD:             collector.FamilyStatsD,
+	collectorpb.MetricFamily_METRIC_FAMILY_MEMORY:             collector.FamilyMemory,
+	collectorpb.MetricFamily_METRIC_FAMILY_NETWORK_INTERFACES: collector.FamilyNetwork,
+}
+
+// EnabledFamilies lists the metric families the config turns on, in field order.
+func EnabledFamilies(cfg *config.Config) []collectorpb.MetricFamily {
+	enabled := map[collectorpb.MetricFamily]bool{
+		collectorpb.MetricFamily_METRIC_FAMILY_LOAD_AVERAGE:       cfg.Metrics.EnableLoadAverage,
+		collectorpb.MetricFamily_METRIC_FAMILY_CPU:                cfg.Metrics.EnableCPU,
+		collectorpb.MetricFamily_METRIC_FAMILY_DISK:               cfg.Metrics.EnableDiskUsage,
+		collectorpb.MetricFamily_METRIC_FAMILY_FILESYSTEM:         cfg.Metrics.EnableFileSystemUsage,
+		collectorpb.MetricFamily_METRIC_FAMILY_NETWORK_PROTOCOLS:  cfg.Metrics.EnableNetworkProtocol,
+		collectorpb.MetricFamily_METRIC_FAMILY_CONNECTIONS:        cfg.Metrics.EnableNetworkProtocol,
+		collectorpb.MetricFamily_METRIC_FAMILY_TCP_STATES:         cfg.Metrics.EnableNetworkProtocol,
+		collectorpb.MetricFamily_METRIC_FAMILY_LISTENING_SOCKETS:  cfg.Metrics.EnableNetworkProtocol,
+		collectorpb.MetricFamily_METRIC_FAMILY_KERNEL_ACTIVITY:    cfg.Metrics.EnableKernelActivity,
+		collectorpb.MetricFamily_METRIC_FAMILY_PROCESSES:          cfg.Metrics.EnableProcesses,
+		collectorpb.MetricFamily_METRIC_FAMILY_WATCHLIST:          len(cfg.Watchlist) > 0,
+		collectorpb.MetricFamily_METRIC_FAMILY_PROCESS_EVENTS:     cfg.Metrics.EnableProcessEvents,
+		collectorpb.MetricFamily_METRIC_FAMILY_PROCESS_STATES:     cfg.Metrics.EnableProcessStates,
+		collectorpb.MetricFamily_METRIC_FAMILY_CGROUPS:            cfg.Metrics.EnableCgroups,
+		collectorpb.MetricFamily_METRIC_FAMILY_NAMESPACES:         cfg.Metrics.EnableNamespaces,
+		collectorpb.MetricFamily_METRIC_FAMILY_FILE_DESCRIPTORS:   cfg.Metrics.EnableFileDescriptors,
+		collectorpb.MetricFamily_METRIC_FAMILY_SENSORS:            cfg.Metrics.EnableSensors,
+		collectorpb.MetricFamily_METRIC_FAMILY_STATSD:             cfg.Metrics.EnableStatsD,
+		collectorpb.MetricFamily_METRIC_FAMILY_MEMORY:             cfg.Metrics.EnableMemory,
+		collectorpb.MetricFamily_METRIC_FAMILY_NETWORK_INTERFACES: cfg.Metrics.EnableNetworkProtocol,
+	}
+	families := make([]collectorpb.MetricFamily, 0, len(enabled))
+	for family, on := range enabled {
+		if on {
+			families = append(families, family)
+		}
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i] < families[j]
+	})
+	return families
+}
+
+// selectFamilies resolves the families of a request, an empty list means every enabled one.
+func (s *MetricsCollectorServer) selectFamilies(requested []collectorpb.MetricFamily) (familySet, error) {
+	if len(requested) == 0 {
+		return s.families, nil
+	}
+	selected := make(familySet, len(requested))
+	for _, family := range requested {
+		if _, ok := collectorpb.MetricFamily_name[int32(family)]; !ok || family == collectorpb.MetricFamily_METRIC_FAMILY_UNKNOWN {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown metric family %d", family)
+		}
+		if !s.families[family] {
+			return nil, status.Errorf(codes.FailedPrecondition, "metric family %s is disabled in server config", family)
+		}
+		selected[family] = true
+	}
+	return selected, nil
+}
+
+func topConnections(connections []*collectorpb.TrafficInfo, top int) []*collectorpb.TrafficInfo {
+	if top <= 0 || len(connections) <= top {
+		return connections
+	}
+	sort.SliceStable(connections, func(i, j int) bool {
+		return connections[i].Bytes > connections[j].Bytes
+	})
+	return connections[:top]
+}
+
+// convertSamples averages the samples into the families the request selected, the rest stay
+// empty and their reducers do not run. Which families the shared sampler collects is decided by
+// the config alone, a request only picks among them.
+func convertSamples(dataList []*collector.Collector, sel selection) *collectorpb.Collector {
+	result := &collectorpb.Collector{}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_LOAD_AVERAGE] {
+		result.Loadaverage = averageLoad(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_CPU] {
+		result.Cpuusage = averageCPU(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_DISK] {
+		result.Diskusage = averageDisks(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_FILESYSTEM] {
+		result.Filesystemusage = averageFileSystems(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_NETWORK_PROTOCOLS] {
+		result.Networkprotocol = sumNetworkProtocols(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_CONNECTIONS] {
+		result.Trafficinfo = topConnections(averageConnections(dataList), sel.topConnections)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_TCP_STATES] {
+		result.Tcpstates = averageTCPStates(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_LISTENING_SOCKETS] {
+		result.Listeningsocket = averageListeningSockets(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_KERNEL_ACTIVITY] {
+		result.Kernelactivity = averageKernelActivity(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_PROCESSES] {
+		result.Processusage = topProcesses(averageProcesses(dataList), sel.processSort, sel.topProcesses)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_WATCHLIST] {
+		result.Watchedprocess = averageWatchedProcesses(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_PROCESS_EVENTS] {
+		result.Processevents = averageProcessEvents(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_PROCESS_STATES] {
+		result.Processstates = latestProcessStates(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_CGROUPS] {
+		result.Cgroupusage = averageCgroups(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_NAMESPACES] {
+		result.Networknamespace = latestNamespaces(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_FILE_DESCRIPTORS] {
+		result.Filedescriptors = latestFileDescriptors(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_SENSORS] {
+		result.Sensors = averageSensors(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_MEMORY] {
+		result.Memory = averageMemory(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_NETWORK_INTERFACES] {
+		result.Networkinterface = latestInterfaces(dataList)
+	}
+	if sel.families[collectorpb.MetricFamily_METRIC_FAMILY_STATSD] {
+		result.Statsd = averageStatsD(dataList)
+	}
+	return result
+}
+
+// familyStatus reports the average collection time of every selected family over the window and
+// the error of its latest collection.
+func familyStatus(dataList []*collector.Collector, families familySet) []*collectorpb.FamilyStatus {
+	stat := []*collectorpb.FamilyStatus{}
+	for family := range families {
+		name, ok := collectorFamilies[family]
+		if !ok {
+			continue
+		}
+		var (
+			total   time.Duration
+			count   int
+			lastErr error
+		)
+		for _, metrics := range dataList {
+			if status, ok := metrics.Status[name]; ok {
+				total += status.Duration
+				count++
+				lastErr = status.Err
+			}
+		}
+		if count == 0 {
+			continue
+		}
+		familyStat := &collectorpb.FamilyStatus{
+			Family:   family,
+			Duration: durationpb.New(total / time.Duration(count)),
+		}
+		if lastErr != nil {
+			familyStat.Error = lastErr.Error()
+		}
+		stat = append(stat, familyStat)
+	}
+	sort.Slice(stat, func(i, j int) bool {
+		return stat[i].Family < stat[j].Family
+	})
+	return stat
+}
@@ -0,0 +1,406 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/web"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	contentTypeJSON        = "application/json"
+	contentTypeNDJSON      = "application/x-ndjson"
+	contentTypeEventStream = "text/event-stream"
+)
+
+// gateway serves the gRPC API as JSON over HTTP. Unary calls are GET endpoints, streams are
+// sent as Server-Sent Events or as newline delimited JSON, picked by the Accept header, or
+// over a WebSocket. With dashboard set the web dashboard is served from the root.
+func (s *MetricsCollectorServer) gateway(dashboard bool) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/metrics", s.gatewayMetrics)
+	mux.HandleFunc("GET /api/v1/events", s.gatewayEvents)
+	mux.HandleFunc("GET /api/v1/snapshot", s.gatewaySnapshot)
+	mux.HandleFunc("GET /api/v1/query", s.gatewayQuery)
+	mux.HandleFunc("GET /api/v1/host", s.gatewayHostInfo)
+	mux.HandleFunc("GET /api/v1/capabilities", s.gatewayCapabilities)
+	mux.HandleFunc("GET /api/v1/ws", s.gatewayWebSocket)
+	if dashboard {
+		mux.Handle("GET /", web.Handler())
+	}
+	return mux
+}
+
+// httpStatus follows the mapping of the gRPC status codes used by grpc-gateway.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+type gatewayError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func errorBody(err error) []byte {
+	st := status.Convert(err)
+	body, _ := json.Marshal(gatewayError{Code: st.Code().String(), Message: st.Message()})
+	return body
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.WriteHeader(httpStatus(status.Code(err)))
+	w.Write(errorBody(err))
+}
+
+func writeMessage(w http.ResponseWriter, message proto.Message, err error) {
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	body, err := protojson.Marshal(message)
+	if err != nil {
+		writeError(w, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", contentTypeJSON)
+	w.Write(body)
+}
+
+// query reads the parameters of a request and keeps the first error.
+type query struct {
+	values map[string][]string
+	err    error
+}
+
+func (q *query) fail(name string, err error) {
+	if q.err == nil {
+		q.err = status.Errorf(codes.InvalidArgument, "invalid %s: %v", name, err)
+	}
+}
+
+func (q *query) list(name string) []string {
+	var items []string
+	for _, value := range q.values[name] {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
+}
+
+func (q *query) int(name string) int32 {
+	value := q.values[name]
+	if len(value) == 0 || value[0] == "" {
+		return 0
+	}
+	parsed, err := strconv.ParseInt(value[0], 10, 32)
+	if err != nil {
+		q.fail(name, err)
+	}
+	return int32(parsed)
+}
+
+func (q *query) bool(name string) bool {
+	value := q.values[name]
+	if len(value) == 0 || value[0] == "" {
+		return false
+	}
+	parsed, err := strconv.ParseBool(value[0])
+	if err != nil {
+		q.fail(name, err)
+	}
+	return parsed
+}
+
+// seconds takes a whole number of seconds or a duration such as "1m".
+func (q *query) seconds(name string) int32 {
+	value := q.values[name]
+	if len(value) == 0 || value[0] == "" {
+		return 0
+	}
+	if parsed, err := strconv.ParseInt(value[0], 10, 32); err == nil {
+		return int32(parsed)
+	}
+	parsed, err := time.ParseDuration(value[0])
+	if err != nil {
+		q.fail(name, err)
+	}
+	return int32(parsed / time.Second)
+}
+
+func (q *query) duration(name string) *durationpb.Duration {
+	value := q.values[name]
+	if len(value) == 0 || value[0] == "" {
+		return nil
+	}
+	if parsed, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+		return durationpb.New(time.Duration(parsed) * time.Second)
+	}
+	parsed, err := time.ParseDuration(value[0])
+	if err != nil {
+		q.fail(name, err)
+	}
+	return durationpb.New(parsed)
+}
+
+// time takes RFC 3339, unix seconds or a negative duration relative to now such as "-1h".
+func (q *query) time(name string) *timestamppb.Timestamp {
+	value := q.values[name]
+	if len(value) == 0 || value[0] == "" {
+		return nil
+	}
+	if parsed, err := time.Parse(time.RFC3339Nano, value[0]); err == nil {
+		return timestamppb.New(parsed)
+	}
+	if parsed, err := strconv.ParseFloat(value[0], 64); err == nil {
+		return timestamppb.New(time.UnixMilli(int64(parsed * 1000)))
+	}
+	if parsed, err := time.ParseDuration(value[0]); err == nil && parsed < 0 {
+		return timestamppb.New(time.Now().Add(parsed))
+	}
+	q.fail(name, fmt.Errorf("%q is neither RFC 3339, unix seconds nor a negative duration", value[0]))
+	return nil
+}
+
+// families takes the enum names with or without the METRIC_FAMILY_ prefix in any case.
+func (q *query) families() []collectorpb.MetricFamily {
+	var families []collectorpb.MetricFamily
+	for _, name := range q.list("families") {
+		name = strings.ToUpper(name)
+		if !strings.HasPrefix(name, "METRIC_FAMILY_") {
+			name = "METRIC_FAMILY_" + name
+		}
+		family, ok := collectorpb.MetricFamily_value[name]
+		if !ok {
+			q.fail("families", fmt.Errorf("unknown family %q", name))
+			continue
+		}
+		families = append(families, collectorpb.MetricFamily(family))
+	}
+	return families
+}
+
+func (q *query) processSort() collectorpb.ProcessSortKey {
+	value := q.values["process_sort"]
+	if len(value) == 0 || value[0] == "" {
+		return collectorpb.ProcessSortKey_PROCESS_SORT_CPU
+	}
+	key, ok := collectorpb.ProcessSortKey_value["PROCESS_SORT_"+strings.ToUpper(value[0])]
+	if !ok {
+		q.fail("process_sort", fmt.Errorf("unknown key %q", value[0]))
+	}
+	return collectorpb.ProcessSortKey(key)
+}
+
+func (q *query) eventTypes() []collectorpb.ProcessEventType {
+	var types []collectorpb.ProcessEventType
+	for _, name := range q.list("types") {
+		eventType, ok := processEventTypes[strings.ToLower(name)]
+		if !ok {
+			q.fail("types", fmt.Errorf("unknown event type %q", name))
+			continue
+		}
+		types = append(types, eventType)
+	}
+	return types
+}
+
+// eventStream adapts an HTTP response to a server stream of the gRPC service. Only Send and
+// Context are used by the stream handlers.
+type eventStream[T proto.Message] struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	event   string
+	sse     bool
+	started bool
+}
+
+func newEventStream[T proto.Message](w http.ResponseWriter, r *http.Request, event string) *eventStream[T] {
+	return &eventStream[T]{
+		ctx:   r.Context(),
+		w:     w,
+		event: event,
+		sse:   strings.Contains(r.Header.Get("Accept"), contentTypeEventStream) || r.URL.Query().Get("format") == "sse",
+	}
+}
+
+func (e *eventStream[T]) Context() context.Context {
+	return e.ctx
+}
+
+func (e *eventStream[T]) write(event string, data []byte) error {
+	if !e.started {
+		e.started = true
+		if e.sse {
+			e.w.Header().Set("Content-Type", contentTypeEventStream)
+			e.w.Header().Set("Cache-Control", "no-cache")
+		} else {
+			e.w.Header().Set("Content-Type", contentTypeNDJSON)
+		}
+		e.w.WriteHeader(http.StatusOK)
+	}
+	var err error
+	if e.sse {
+		_, err = fmt.Fprintf(e.w, "event: %s\ndata: %s\n\n", event, data)
+	} else {
+		_, err = fmt.Fprintf(e.w, "%s\n", data)
+	}
+	if flusher, ok := e.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return err
+}
+
+func (e *eventStream[T]) Send(message T) error {
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return err
+	}
+	return e.write(e.event, data)
+}
+
+// finish reports the error that ended the stream, as the status when nothing was sent yet.
+func (e *eventStream[T]) finish(err error) {
+	if err == nil || e.ctx.Err() != nil {
+		return
+	}
+	if !e.started {
+		writeError(e.w, err)
+		return
+	}
+	if e.sse {
+		e.write("error", errorBody(err))
+		return
+	}
+	e.write("", []byte(`{"error":`+string(errorBody(err))+`}`))
+}
+
+// metricsRequest reads the parameters shared by /api/v1/metrics and /api/v1/ws.
+func (s *MetricsCollectorServer) metricsRequest(q *query) *collectorpb.MetricsRequest {
+	req := &collectorpb.MetricsRequest{
+		NSecond:          q.seconds("interval"),
+		MSecond:          q.seconds("window"),
+		TopProcesses:     q.int("top_processes"),
+		ProcessSort:      q.processSort(),
+		Families:         q.families(),
+		TopConnections:   q.int("top_connections"),
+		Delta:            q.bool("delta"),
+		KeyframeInterval: q.int("keyframe_interval"),
+	}
+	if req.NSecond == 0 {
+		req.NSecond = int32(s.minInterval / time.Second)
+	}
+	return req
+}
+
+func (s *MetricsCollectorServer) gatewayMetrics(w http.ResponseWriter, r *http.Request) {
+	q := &query{values: r.URL.Query()}
+	req := s.metricsRequest(q)
+	if q.err != nil {
+		writeError(w, q.err)
+		return
+	}
+	stream := newEventStream[*collectorpb.MetricsResponse](w, r, "metrics")
+	stream.finish(s.CollectMetrics(req, stream))
+}
+
+func (s *MetricsCollectorServer) gatewayEvents(w http.ResponseWriter, r *http.Request) {
+	q := &query{values: r.URL.Query()}
+	req := &collectorpb.ProcessEventsRequest{
+		IncludeHistory: q.bool("include_history"),
+		Types:          q.eventTypes(),
+	}
+	if q.err != nil {
+		writeError(w, q.err)
+		return
+	}
+	stream := newEventStream[*collectorpb.ProcessEvent](w, r, "process_event")
+	stream.finish(s.StreamProcessEvents(req, stream))
+}
+
+func (s *MetricsCollectorServer) gatewaySnapshot(w http.ResponseWriter, r *http.Request) {
+	q := &query{values: r.URL.Query()}
+	req := &collectorpb.SnapshotRequest{
+		WindowSeconds:  q.seconds("window"),
+		TopProcesses:   q.int("top_processes"),
+		ProcessSort:    q.processSort(),
+		Families:       q.families(),
+		TopConnections: q.int("top_connections"),
+	}
+	if q.err != nil {
+		writeError(w, q.err)
+		return
+	}
+	resp, err := s.GetSnapshot(r.Context(), req)
+	writeMessage(w, resp, err)
+}
+
+func (s *MetricsCollectorServer) gatewayQuery(w http.ResponseWriter, r *http.Request) {
+	q := &query{values: r.URL.Query()}
+	req := &collectorpb.QueryRangeRequest{
+		Start:          q.time("start"),
+		End:            q.time("end"),
+		Step:           q.duration("step"),
+		Families:       q.families(),
+		TopProcesses:   q.int("top_processes"),
+		ProcessSort:    q.processSort(),
+		TopConnections: q.int("top_connections"),
+	}
+	if q.err != nil {
+		writeError(w, q.err)
+		return
+	}
+	resp, err := s.QueryRange(r.Context(), req)
+	writeMessage(w, resp, err)
+}
+
+func (s *MetricsCollectorServer) gatewayHostInfo(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.GetHostInfo(r.Context(), &collectorpb.HostInfoRequest{})
+	writeMessage(w, resp, err)
+}
+
+func (s *MetricsCollectorServer) gatewayCapabilities(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.GetCapabilities(r.Context(), &collectorpb.CapabilitiesRequest{})
+	writeMessage(w, resp, err)
+}
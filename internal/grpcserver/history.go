@@ -0,0 +1,140 @@
+package grpcserver
+
+import (
+	"log/slog"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/config"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/storage"
+)
+
+// history writes every sample to the raw tier of the store and rolls them up: the first
+// rollup averages the samples of a minute with the stream reducers, the coarser ones
+// average the points of the tier below.
+type history struct {
+	server  *MetricsCollectorServer
+	store   *storage.Store
+	tiers   []storage.Tier
+	sel     selection
+	minute  []*collector.Collector
+	pending [][]*collectorpb.MetricsResponse
+}
+
+func newHistory(server *MetricsCollectorServer, store *storage.Store, sel selection) *history {
+	tiers := store.Tiers()
+	return &history{
+		server:  server,
+		store:   store,
+		tiers:   tiers,
+		sel:     sel,
+		pending: make([][]*collectorpb.MetricsResponse, len(tiers)),
+	}
+}
+
+// storageTiers applies the configured retentions to the default tiers.
+func storageTiers(cfg *config.Config) []storage.Tier {
+	retention := map[string]time.Duration{
+		storage.TierRaw:         cfg.Storage.Retention.Raw,
+		storage.TierMinute:      cfg.Storage.Retention.Minute,
+		storage.TierFiveMinutes: cfg.Storage.Retention.FiveMinutes,
+		storage.TierHour:        cfg.Storage.Retention.Hour,
+	}
+	tiers := storage.DefaultTiers()
+	for i := range tiers {
+		if configured := retention[tiers[i].Name]; configured > 0 {
+			tiers[i].Retention = configured
+		}
+	}
+	return tiers
+}
+
+func sameBucket(left, right time.Time, resolution time.Duration) bool {
+	return left.Truncate(resolution).Equal(right.Truncate(resolution))
+}
+
+func (h *history) append(level int, point *collectorpb.MetricsResponse) {
+	if err := h.store.Append(h.tiers[level].Name, point); err != nil {
+		slog.Error("failed to store metrics", "tier", h.tiers[level].Name, "error", err)
+	}
+}
+
+func (h *history) add(sample *collector.Collector) {
+	h.append(0, h.server.response([]*collector.Collector{sample}, h.sel))
+	if len(h.tiers) < 2 {
+		return
+	}
+	if len(h.minute) > 0 && !sameBucket(h.minute[0].Time, sample.Time, h.tiers[1].Resolution) {
+		h.rollup(1, h.server.response(h.minute, h.sel))
+		h.minute = nil
+	}
+	h.minute = append(h.minute, sample)
+}
+
+func (h *history) rollup(level int, point *collectorpb.MetricsResponse) {
+	h.append(level, point)
+	next := level + 1
+	if next >= len(h.tiers) {
+		return
+	}
+	pending := h.pending[next]
+	if len(pending) > 0 && !sameBucket(pointTime(pending[0]), pointTime(point), h.tiers[next].Resolution) {
+		h.rollup(next, averagePoints(pending))
+		pending = nil
+	}
+	h.pending[next] = append(pending, point)
+}
+
+func pointTime(point *collectorpb.MetricsResponse) time.Time {
+	return point.GetWindowEnd().AsTime()
+}
+
+// pickTier prefers the coarsest tier not coarser than step that still covers start, then the
+// finest coarser tier that does.
+func (h *history) pickTier(start time.Time, step time.Duration) storage.Tier {
+	covers := func(t storage.Tier) bool {
+		oldest, ok := h.store.Oldest(t.Name)
+		return ok && !oldest.After(start)
+	}
+	var (
+		fallback = h.tiers[0]
+		best     *storage.Tier
+	)
+	for i, t := range h.tiers {
+		if t.Resolution <= step {
+			fallback = t
+			if covers(t) {
+				best = &h.tiers[i]
+			}
+			continue
+		}
+		if best == nil && covers(t) {
+			return t
+		}
+	}
+	if best != nil {
+		return *best
+	}
+	return fallback
+}
+
+// query answers a range from disk, averaging the points of the picked tier per step.
+func (h *history) query(start, end time.Time, step time.Duration, sel selection) ([]*collectorpb.MetricsResponse, error) {
+	tier := h.pickTier(start, step)
+	points, err := h.store.Query(tier.Name, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if step > tier.Resolution {
+		var averaged []*collectorpb.MetricsResponse
+		for _, bucket := range buckets(points, start, step, pointTime) {
+			averaged = append(averaged, averagePoints(bucket))
+		}
+		points = averaged
+	}
+	for _, point := range points {
+		filterPoint(point, sel)
+	}
+	return points, nil
+}
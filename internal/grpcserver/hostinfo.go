@@ -0,0 +1,41 @@
+package grpcserver
+
+import (
+	"context"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *MetricsCollectorServer) GetHostInfo(_ context.Context, _ *collectorpb.HostInfoRequest) (*collectorpb.HostInfo, error) {
+	info, err := collector.HostInfoStat()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return convertHostInfo(info), nil
+}
+
+func convertHostInfo(info collector.HostInfo) *collectorpb.HostInfo {
+	return &collectorpb.HostInfo{
+		Hostname:      info.Hostname,
+		MachineId:     info.MachineID,
+		KernelRelease: info.KernelRelease,
+		KernelVersion: info.KernelVersion,
+		Architecture:  info.Architecture,
+		OsId:          info.OSID,
+		OsName:        info.OSName,
+		OsVersion:     info.OSVersion,
+		OsPrettyName:  info.OSPrettyName,
+		BootTime:      timestamppb.New(info.BootTime),
+		Uptime:        durationpb.New(info.Uptime),
+		CpuModel:      info.CPUModel,
+		CpuSockets:    int64(info.CPUSockets),
+		CpuCores:      int64(info.CPUCores),
+		CpuThreads:    int64(info.CPUThreads),
+		MemoryTotal:   int64(info.MemoryTotal),
+	}
+}
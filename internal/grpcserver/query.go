@@ -0,0 +1,87 @@
+package grpcserver
+
+import (
+	"context"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxQueryPoints = 10000
+	// maxRawRange bounds queries without a step, one full response per second past it no
+	// longer fits into a gRPC message.
+	maxRawRange = 15 * time.Minute
+)
+
+// QueryRange returns the buffered samples between two timestamps, averaged per step with the
+// same reducers the streams use.
+func (s *MetricsCollectorServer) QueryRange(_ context.Context, req *collectorpb.QueryRangeRequest) (*collectorpb.QueryRangeResponse, error) {
+	families, err := s.selectFamilies(req.GetFamilies())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetStart() == nil {
+		return nil, status.Error(codes.InvalidArgument, "start is required")
+	}
+	start, end := req.GetStart().AsTime(), time.Now()
+	if req.GetEnd() != nil {
+		end = req.GetEnd().AsTime()
+	}
+	if !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "end must be after start")
+	}
+	step := req.GetStep().AsDuration()
+	if step < 0 || (step > 0 && end.Sub(start)/step > maxQueryPoints) {
+		return nil, status.Errorf(codes.InvalidArgument, "step must be positive and yield at most %d points", maxQueryPoints)
+	}
+	if step == 0 && end.Sub(start) > maxRawRange {
+		return nil, status.Errorf(codes.InvalidArgument, "step is required for ranges over %s", maxRawRange)
+	}
+	sel := selection{
+		families:       families,
+		processSort:    req.GetProcessSort(),
+		topProcesses:   int(req.GetTopProcesses()),
+		topConnections: int(req.GetTopConnections()),
+	}
+
+	if s.history != nil && start.Before(s.sampler.oldest()) {
+		points, err := s.history.query(start, end, step, sel)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &collectorpb.QueryRangeResponse{Points: points}, nil
+	}
+	samples := s.sampler.between(start, end)
+	result := &collectorpb.QueryRangeResponse{}
+	if step == 0 {
+		for i := range samples {
+			result.Points = append(result.Points, s.response(samples[i:i+1], sel))
+		}
+		return result, nil
+	}
+	for _, bucket := range buckets(samples, start, step, func(sample *collector.Collector) time.Time {
+		return sample.Time
+	}) {
+		result.Points = append(result.Points, s.response(bucket, sel))
+	}
+	return result, nil
+}
+
+// buckets splits time ordered items into the steps counted from start, leaving out empty steps.
+func buckets[T any](items []T, start time.Time, step time.Duration, at func(T) time.Time) [][]T {
+	var split [][]T
+	for len(items) > 0 {
+		bucketEnd := start.Add((at(items[0]).Sub(start)/step + 1) * step)
+		size := 0
+		for size < len(items) && at(items[size]).Before(bucketEnd) {
+			size++
+		}
+		split = append(split, items[:size])
+		items = items[size:]
+	}
+	return split
+}
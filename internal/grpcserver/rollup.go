@@ -0,0 +1,166 @@
+package grpcserver
+
+import (
+	"fmt"
+	"strings"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// entryKeys names the fields that tell the entries of a list apart across points, the same
+// ones the stream reducers group samples by. Lists of other messages keep the latest entries.
+var entryKeys = map[protoreflect.FullName][]protoreflect.Name{
+	"collector.DiskUsage":             {"name"},
+	"collector.FileSystemUsage":       {"mount_point"},
+	"collector.NetworkProtocol":       {"protocol"},
+	"collector.TrafficInfo":           {"protocol", "sourceip", "source_port", "destip", "dest_port", "State"},
+	"collector.TCPStates":             {"state"},
+	"collector.ListeningSocket":       {"pid", "command", "user"},
+	"collector.Interrupt":             {"name"},
+	"collector.Pressure":              {"resource"},
+	"collector.ProcessUsage":          {"pid"},
+	"collector.WatchedProcess":        {"name"},
+	"collector.CgroupUsage":           {"path"},
+	"collector.NetworkNamespace":      {"inode"},
+	"collector.FileDescriptorProcess": {"pid"},
+	"collector.ThermalZone":           {"zone"},
+	"collector.HwmonSensor":           {"device", "sensor"},
+	"collector.CPUFrequency":          {"cpu"},
+	"collector.StatsDMetric":          {"type", "name", "tags"},
+}
+
+// entryKey joins the key fields of a list entry.
+func entryKey(entry protoreflect.Message, names []protoreflect.Name) string {
+	fields := entry.Descriptor().Fields()
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		field := fields.ByName(name)
+		if !field.IsList() {
+			parts = append(parts, fmt.Sprint(entry.Get(field).Interface()))
+			continue
+		}
+		list := entry.Get(field).List()
+		items := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			items = append(items, fmt.Sprint(list.Get(i).Interface()))
+		}
+		parts = append(parts, strings.Join(items, ","))
+	}
+	return strings.Join(parts, "|")
+}
+
+// averageList replaces the entries of a list in dst with one entry per key seen in srcs, each
+// averaged over the points that carry it on top of its latest value.
+func averageList(dst protoreflect.Message, field protoreflect.FieldDescriptor, srcs []protoreflect.Message) {
+	names, ok := entryKeys[field.Message().FullName()]
+	if !ok {
+		return
+	}
+	var order []string
+	groups := make(map[string][]protoreflect.Message)
+	for _, src := range srcs {
+		list := src.Get(field).List()
+		for i := 0; i < list.Len(); i++ {
+			entry := list.Get(i).Message()
+			key := entryKey(entry, names)
+			if _, ok := groups[key]; !ok {
+				order = append(order, key)
+			}
+			groups[key] = append(groups[key], entry)
+		}
+	}
+	if len(order) == 0 {
+		return
+	}
+	list := dst.Mutable(field).List()
+	list.Truncate(0)
+	for _, key := range order {
+		entries := groups[key]
+		entry := proto.Clone(entries[len(entries)-1].Interface()).ProtoReflect()
+		averageMessage(entry, entries)
+		list.Append(protoreflect.ValueOfMessage(entry))
+	}
+}
+
+// averageMessage sets every floating point field of dst to the mean over srcs, descending
+// into message fields. Entries of keyed lists are matched across srcs by key, other lists and
+// the remaining scalars keep the value of dst.
+func averageMessage(dst protoreflect.Message, srcs []protoreflect.Message) {
+	fields := dst.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.IsMap() {
+			continue
+		}
+		if field.IsList() {
+			if field.Kind() == protoreflect.MessageKind {
+				averageList(dst, field, srcs)
+			}
+			continue
+		}
+		switch field.Kind() {
+		case protoreflect.DoubleKind, protoreflect.FloatKind:
+			var sum float64
+			for _, src := range srcs {
+				sum += src.Get(field).Float()
+			}
+			mean := sum / float64(len(srcs))
+			if field.Kind() == protoreflect.FloatKind {
+				dst.Set(field, protoreflect.ValueOfFloat32(float32(mean)))
+			} else {
+				dst.Set(field, protoreflect.ValueOfFloat64(mean))
+			}
+		case protoreflect.MessageKind:
+			if !dst.Has(field) {
+				continue
+			}
+			var nested []protoreflect.Message
+			for _, src := range srcs {
+				if src.Has(field) {
+					nested = append(nested, src.Get(field).Message())
+				}
+			}
+			averageMessage(dst.Mutable(field).Message(), nested)
+		}
+	}
+}
+
+// averagePoints rolls stored points up into one. Averages such as load, CPU or rates are
+// averaged again, per device, mount point or connection for lists. Gauges are taken from the
+// latest point.
+func averagePoints(points []*collectorpb.MetricsResponse) *collectorpb.MetricsResponse {
+	first, last := points[0], points[len(points)-1]
+	rolled := proto.Clone(last).(*collectorpb.MetricsResponse)
+	rolled.WindowStart = first.GetWindowStart()
+	rolled.Samples = 0
+	collectors := make([]protoreflect.Message, 0, len(points))
+	for _, point := range points {
+		rolled.Samples += point.GetSamples()
+		collectors = append(collectors, point.GetCollector().ProtoReflect())
+	}
+	if rolled.Collector != nil {
+		averageMessage(rolled.Collector.ProtoReflect(), collectors)
+	}
+	return rolled
+}
+
+// filterPoint trims a stored point down to what a request selected.
+func filterPoint(point *collectorpb.MetricsResponse, sel selection) *collectorpb.MetricsResponse {
+	if point.Collector == nil {
+		return point
+	}
+	message := point.Collector.ProtoReflect()
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		// Family numbers match the Collector field they fill.
+		if !sel.families[collectorpb.MetricFamily(field.Number())] {
+			message.Clear(field)
+		}
+	}
+	point.Collector.Processusage = topProcesses(point.Collector.Processusage, sel.processSort, sel.topProcesses)
+	point.Collector.Trafficinfo = topConnections(point.Collector.Trafficinfo, sel.topConnections)
+	return point
+}
@@ -0,0 +1,119 @@
+package grpcserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+)
+
+const defaultRetention = 300 * time.Second
+
+// sampler collects in the background and keeps the recent samples for every client to share,
+// so concurrent streams and snapshots do not each run their own collection.
+type sampler struct {
+	options   collector.Options
+	retention time.Duration
+
+	// observe sees every sample right after it is buffered.
+	observe func(*collector.Collector)
+
+	mu       sync.RWMutex
+	samples  []*collector.Collector
+	sequence uint64
+	ready    chan struct{}
+}
+
+func newSampler(options collector.Options, retention time.Duration) *sampler {
+	if retention <= 0 {
+		retention = defaultRetention
+	}
+	return &sampler{
+		options:   options,
+		retention: retention,
+		ready:     make(chan struct{}),
+	}
+}
+
+// run collects once a second until ctx is done, the rate collectors already span that second
+// so their samples follow each other back to back.
+func (s *sampler) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		data := collector.Collect(s.options)
+		s.add(data)
+		if s.observe != nil {
+			s.observe(data)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *sampler) add(data *collector.Collector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.samples) == 0 {
+		close(s.ready)
+	}
+	s.sequence++
+	data.Sequence = s.sequence
+	s.samples = append(s.samples, data)
+	cutoff := data.Time.Add(-s.retention)
+	expired := 0
+	for expired < len(s.samples)-1 && s.samples[expired].Time.Before(cutoff) {
+		expired++
+	}
+	s.samples = append(s.samples[:0], s.samples[expired:]...)
+}
+
+// window returns the samples taken during the last period, or the latest one when none is
+// that recent. It waits for the first sample when nothing has been collected yet.
+func (s *sampler) window(ctx context.Context, period time.Duration) ([]*collector.Collector, error) {
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cutoff := time.Now().Add(-period)
+	first := len(s.samples) - 1
+	for first > 0 && !s.samples[first-1].Time.Before(cutoff) {
+		first--
+	}
+	return append([]*collector.Collector(nil), s.samples[first:]...), nil
+}
+
+// between returns the samples collected from start up to and including end.
+func (s *sampler) between(start, end time.Time) []*collector.Collector {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	first := sort.Search(len(s.samples), func(i int) bool {
+		return !s.samples[i].Time.Before(start)
+	})
+	last := sort.Search(len(s.samples), func(i int) bool {
+		return s.samples[i].Time.After(end)
+	})
+	if first >= last {
+		return nil
+	}
+	return append([]*collector.Collector(nil), s.samples[first:last]...)
+}
+
+// oldest returns when the first buffered sample was taken, now when there is none yet.
+func (s *sampler) oldest() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.samples) == 0 {
+		return time.Now()
+	}
+	return s.samples[0].Time
+}
@@ -3,56 +3,80 @@ package grpcserver
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"math"
 	"net"
-	"sync"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
 	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/config"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/storage"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MetricsCollectorServer struct {
 	collectorpb.UnimplementedMetricsCollectorServer
-	collector *collector.Collector
+	options  collector.Options
+	families familySet
+	sampler  *sampler
+	hostname string
+	history  *history
+	// defaults is what history and the exporters publish: every enabled family and the
+	// configured top processes.
+	defaults selection
+
+	minInterval time.Duration
+	maxInterval time.Duration
 }
 
 func (s *MetricsCollectorServer) CollectMetrics(req *collectorpb.MetricsRequest, stream collectorpb.MetricsCollector_CollectMetricsServer) error {
+	families, err := s.selectFamilies(req.GetFamilies())
+	if err != nil {
+		return err
+	}
+	sel := selection{
+		families:       families,
+		processSort:    req.GetProcessSort(),
+		topProcesses:   int(req.GetTopProcesses()),
+		topConnections: int(req.GetTopConnections()),
+	}
 	period := time.Duration(req.GetNSecond()) * time.Second
+	if period < s.minInterval || period > s.maxInterval {
+		return status.Errorf(codes.InvalidArgument, "n_second must be between %v and %v", s.minInterval, s.maxInterval)
+	}
+	window := time.Duration(req.GetMSecond()) * time.Second
+	if window <= 0 {
+		window = period
+	}
+	if window > s.sampler.retention {
+		return status.Errorf(codes.InvalidArgument, "m_second must not exceed %v", s.sampler.retention)
+	}
+	var encoder *deltaEncoder
+	if req.GetDelta() {
+		encoder = newDeltaEncoder(int(req.GetKeyframeInterval()))
+	}
 	ticker := time.NewTicker(period)
 	defer ticker.Stop()
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	var (
-		dataList []*collector.Collector
-		mu       sync.Mutex
-	)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				data := collector.Collect()
-				mu.Lock()
-				dataList = append(dataList, data)
-				mu.Unlock()
-			case <-ctx.Done():
-				return
-			}
-		}
-	}()
 
 	for {
 		select {
 		case <-ticker.C:
-			mu.Lock()
-			averageData := computeAverages(dataList)
-			mu.Unlock()
-
-			response := &collectorpb.MetricsResponse{
-				Collector: averageData,
+			dataList, err := s.sampler.window(stream.Context(), window)
+			if err != nil {
+				return err
+			}
+			response := s.response(dataList, sel)
+			if encoder != nil {
+				encoder.encode(response)
 			}
-
 			if err := stream.Send(response); err != nil {
 				return err
 			}
@@ -62,42 +86,74 @@ func (s *MetricsCollectorServer) CollectMetrics(req *collectorpb.MetricsRequest,
 	}
 }
 
-func computeAverages(dataList []*collector.Collector) *collectorpb.Collector {
-	count := len(dataList)
-	if count == 0 {
-		return &collectorpb.Collector{}
+// GetSnapshot answers with the latest sample, or the average over the requested window.
+func (s *MetricsCollectorServer) GetSnapshot(ctx context.Context, req *collectorpb.SnapshotRequest) (*collectorpb.MetricsResponse, error) {
+	families, err := s.selectFamilies(req.GetFamilies())
+	if err != nil {
+		return nil, err
 	}
-	avgLoad := &collectorpb.LoadAverage{}
-	avgCPU := &collectorpb.CPUUsage{}
-	avgDisk := []*collectorpb.DiskUsage{}
-	avgConnections := []*collectorpb.TrafficInfo{}
-	avgTCPState := []*collectorpb.TCPStates{}
-	avgListeningSockets := []*collectorpb.ListeningSocket{}
-	avgFileSystemUsages := []*collectorpb.FileSystemUsage{}
-	protocolBytes := make(map[string]int64)
-	for _, metrics := range dataList {
-		avgLoad.OneMinute += metrics.LoadAverage.OneMinute
-		avgLoad.FiveMinutes += metrics.LoadAverage.FiveMinutes
-		avgLoad.FifteenMinutes += metrics.LoadAverage.FifteenMinutes
+	window := time.Duration(req.GetWindowSeconds()) * time.Second
+	if window > s.sampler.retention {
+		return nil, status.Errorf(codes.InvalidArgument, "window_seconds must not exceed %v", s.sampler.retention)
+	}
+	dataList, err := s.sampler.window(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+	return s.response(dataList, selection{
+		families:       families,
+		processSort:    req.GetProcessSort(),
+		topProcesses:   int(req.GetTopProcesses()),
+		topConnections: int(req.GetTopConnections()),
+	}), nil
+}
 
-		avgCPU.UserMode += metrics.CPUUsage.UserMode
-		avgCPU.SystemMode += metrics.CPUUsage.SystemMode
-		avgCPU.Idle += metrics.CPUUsage.Idle
+// response averages a window of samples, dataList always holds at least one sample.
+func (s *MetricsCollectorServer) response(dataList []*collector.Collector, sel selection) *collectorpb.MetricsResponse {
+	first, last := dataList[0], dataList[len(dataList)-1]
+	return &collectorpb.MetricsResponse{
+		Collector:    convertSamples(dataList, sel),
+		WindowStart:  timestamppb.New(first.Time),
+		WindowEnd:    timestamppb.New(last.Time),
+		Samples:      int64(len(dataList)),
+		Sequence:     int64(last.Sequence),
+		FamilyStatus: familyStatus(dataList, sel.families),
+		Hostname:     s.hostname,
+	}
+}
 
-		avgLoad.OneMinute /= float64(count)
-		avgLoad.FiveMinutes /= float64(count)
-		avgLoad.FifteenMinutes /= float64(count)
+func averageLoad(dataList []*collector.Collector) *collectorpb.LoadAverage {
+	count := float64(len(dataList))
+	avgLoad := &collectorpb.LoadAverage{}
+	for _, metrics := range dataList {
+		avgLoad.OneMinute += metrics.LoadAverage.OneMinute / count
+		avgLoad.FiveMinutes += metrics.LoadAverage.FiveMinutes / count
+		avgLoad.FifteenMinutes += metrics.LoadAverage.FifteenMinutes / count
+	}
+	return avgLoad
+}
 
-		avgCPU.UserMode /= float64(count)
-		avgCPU.SystemMode /= float64(count)
-		avgCPU.Idle /= float64(count)
+func averageCPU(dataList []*collector.Collector) *collectorpb.CPUUsage {
+	count := float64(len(dataList))
+	avgCPU := &collectorpb.CPUUsage{}
+	for _, metrics := range dataList {
+		avgCPU.UserMode += metrics.CPUUsage.UserMode / count
+		avgCPU.SystemMode += metrics.CPUUsage.SystemMode / count
+		avgCPU.Idle += metrics.CPUUsage.Idle / count
+	}
+	return avgCPU
+}
 
+func averageDisks(dataList []*collector.Collector) []*collectorpb.DiskUsage {
+	count := float64(len(dataList))
+	avgDisk := []*collectorpb.DiskUsage{}
+	for _, metrics := range dataList {
 		for _, diskState := range metrics.DiskUsage {
 			found := false
 			for _, avgState := range avgDisk {
 				if avgState.Name == diskState.Name {
-					avgState.Kbpersec += diskState.KBPerSec
-					avgState.Tps += diskState.TPS
+					avgState.Kbpersec += diskState.KBPerSec / count
+					avgState.Tps += diskState.TPS / count
 					found = true
 					break
 				}
@@ -105,12 +161,18 @@ func computeAverages(dataList []*collector.Collector) *collectorpb.Collector {
 			if !found {
 				avgDisk = append(avgDisk, &collectorpb.DiskUsage{
 					Name:     diskState.Name,
-					Tps:      diskState.TPS,
-					Kbpersec: diskState.KBPerSec,
+					Tps:      diskState.TPS / count,
+					Kbpersec: diskState.KBPerSec / count,
 				})
 			}
 		}
+	}
+	return avgDisk
+}
 
+func averageConnections(dataList []*collector.Collector) []*collectorpb.TrafficInfo {
+	avgConnections := []*collectorpb.TrafficInfo{}
+	for _, metrics := range dataList {
 		for _, conn := range metrics.TrafficInfo {
 			found := false
 			for _, avgConn := range avgConnections {
@@ -132,10 +194,17 @@ func computeAverages(dataList []*collector.Collector) *collectorpb.Collector {
 					Bps:        conn.BPS,
 					Bytes:      int64(conn.Bytes),
 					State:      conn.State,
+					Container:  convertContainer(conn.Container),
 				})
 			}
 		}
+	}
+	return avgConnections
+}
 
+func averageListeningSockets(dataList []*collector.Collector) []*collectorpb.ListeningSocket {
+	avgListeningSockets := []*collectorpb.ListeningSocket{}
+	for _, metrics := range dataList {
 		for _, socket := range metrics.ListeningSocket {
 			found := false
 			for _, avgSocket := range avgListeningSockets {
@@ -146,14 +215,22 @@ func computeAverages(dataList []*collector.Collector) *collectorpb.Collector {
 			}
 			if !found {
 				avgListeningSockets = append(avgListeningSockets, &collectorpb.ListeningSocket{
-					Command:  socket.Command,
-					User:     socket.User,
-					Protocol: socket.Protocol,
-					Pid:      int64(socket.PID),
-					Port:     int64(socket.Port),
+					Command:   socket.Command,
+					User:      socket.User,
+					Protocol:  socket.Protocol,
+					Pid:       int64(socket.PID),
+					Port:      int64(socket.Port),
+					Container: convertContainer(socket.Container),
 				})
 			}
 		}
+	}
+	return avgListeningSockets
+}
+
+func averageTCPStates(dataList []*collector.Collector) []*collectorpb.TCPStates {
+	avgTCPState := []*collectorpb.TCPStates{}
+	for _, metrics := range dataList {
 		for _, state := range metrics.TCPStates {
 			found := false
 			for _, avgState := range avgTCPState {
@@ -170,31 +247,48 @@ func computeAverages(dataList []*collector.Collector) *collectorpb.Collector {
 				})
 			}
 		}
+	}
+	return avgTCPState
+}
 
+func averageFileSystems(dataList []*collector.Collector) []*collectorpb.FileSystemUsage {
+	count := float64(len(dataList))
+	avgFileSystemUsages := []*collectorpb.FileSystemUsage{}
+	for _, metrics := range dataList {
 		for _, fs := range metrics.FileSystemUsage {
 			found := false
 			for _, avgFs := range avgFileSystemUsages {
-				if avgFs.FileSystem == fs.FileSystem {
-					avgFs.Usedmb += fs.UsedMB
-					avgFs.UsedInode += fs.UsedInode
+				if avgFs.MountPoint == fs.MountPoint {
+					avgFs.Usedmb += fs.UsedMB / count
+					avgFs.UsedPercent += fs.UsedPercent / count
+					avgFs.UsedInode += fs.UsedInode / count
+					avgFs.InodePercent += fs.InodePercent / count
 					found = true
 					break
 				}
 			}
 			if !found {
 				avgFileSystemUsages = append(avgFileSystemUsages, &collectorpb.FileSystemUsage{
-					FileSystem: fs.FileSystem,
-					Usedmb:     fs.UsedMB / float64(count),
-					UsedInode:  fs.UsedInode / float64(count),
+					FileSystem:   fs.FileSystem,
+					MountPoint:   fs.MountPoint,
+					Usedmb:       fs.UsedMB / count,
+					UsedPercent:  fs.UsedPercent / count,
+					UsedInode:    fs.UsedInode / count,
+					InodePercent: fs.InodePercent / count,
 				})
 			}
 		}
+	}
+	return avgFileSystemUsages
+}
 
+func sumNetworkProtocols(dataList []*collector.Collector) []*collectorpb.NetworkProtocol {
+	protocolBytes := make(map[string]int64)
+	for _, metrics := range dataList {
 		for _, proto := range metrics.NetworkProtocol {
 			protocolBytes[proto.Protocol] += proto.Bytes
 		}
 	}
-
 	networkProtocols := []*collectorpb.NetworkProtocol{}
 	for proto, bytes := range protocolBytes {
 		networkProtocols = append(networkProtocols, &collectorpb.NetworkProtocol{
@@ -202,24 +296,581 @@ func computeAverages(dataList []*collector.Collector) *collectorpb.Collector {
 			Bytes:    bytes,
 		})
 	}
+	return networkProtocols
+}
 
-	return &collectorpb.Collector{
-		Loadaverage:     avgLoad,
-		Cpuusage:        avgCPU,
-		Filesystemusage: avgFileSystemUsages,
-		Networkprotocol: networkProtocols,
-		Trafficinfo:     avgConnections,
-		Tcpstates:       avgTCPState,
-		Listeningsocket: avgListeningSockets,
-		Diskusage:       avgDisk,
+func averageKernelActivity(dataList []*collector.Collector) *collectorpb.KernelActivity {
+	count := float64(len(dataList))
+	avgKernel := &collectorpb.KernelActivity{}
+	avgIRQ := make(map[string]*collectorpb.Interrupt)
+	avgSoftIRQ := make(map[string]*collectorpb.Interrupt)
+	for _, metrics := range dataList {
+		kernel := metrics.KernelActivity
+		avgKernel.ContextSwitches += kernel.ContextSwitchesPerSec / count
+		avgKernel.Interrupts += kernel.InterruptsPerSec / count
+		avgKernel.Forks += kernel.ForksPerSec / count
+		avgKernel.Softirqs += kernel.SoftIRQPerSec / count
+		avgKernel.ProcsRunning = int64(kernel.ProcsRunning)
+		avgKernel.ProcsBlocked = int64(kernel.ProcsBlocked)
+		avgKernel.BootTime = int64(kernel.BootTime)
+		averageInterrupts(avgIRQ, kernel.Interrupts, count)
+		averageInterrupts(avgSoftIRQ, kernel.SoftIRQs, count)
 	}
+	avgKernel.Irqs = sortedInterrupts(avgIRQ)
+	avgKernel.Softirq = sortedInterrupts(avgSoftIRQ)
+	return avgKernel
 }
 
-func StartServer(col *collector.Collector, grpcport string) {
-	server := grpc.NewServer()
-	collectorpb.RegisterMetricsCollectorServer(server, &MetricsCollectorServer{
-		collector: col,
+func averageInterrupts(avg map[string]*collectorpb.Interrupt, irqs []collector.Interrupt, count float64) {
+	for _, irq := range irqs {
+		avgIRQ, ok := avg[irq.Name]
+		if !ok {
+			avgIRQ = &collectorpb.Interrupt{Name: irq.Name}
+			avg[irq.Name] = avgIRQ
+		}
+		avgIRQ.Description = irq.Description
+		avgIRQ.Count = int64(irq.Count)
+		avgIRQ.PerSecond += irq.PerSec / count
+	}
+}
+
+func sortedInterrupts(avg map[string]*collectorpb.Interrupt) []*collectorpb.Interrupt {
+	irqs := make([]*collectorpb.Interrupt, 0, len(avg))
+	for _, irq := range avg {
+		irqs = append(irqs, irq)
+	}
+	sort.Slice(irqs, func(i, j int) bool {
+		return irqs[i].Name < irqs[j].Name
+	})
+	return irqs
+}
+
+func averageProcesses(dataList []*collector.Collector) []*collectorpb.ProcessUsage {
+	avgProcess := make(map[int64]*collectorpb.ProcessUsage)
+	samples := make(map[int64]float64)
+	for _, metrics := range dataList {
+		for _, process := range metrics.ProcessUsage {
+			pid := int64(process.PID)
+			avg, ok := avgProcess[pid]
+			if !ok {
+				avg = &collectorpb.ProcessUsage{Pid: pid}
+				avgProcess[pid] = avg
+			}
+			samples[pid]++
+			avg.Ppid = int64(process.PPID)
+			avg.Command = process.Command
+			avg.Cmdline = process.Cmdline
+			avg.User = process.User
+			avg.State = process.State
+			avg.RssBytes = int64(process.RSSBytes)
+			avg.SwapBytes = int64(process.SwapBytes)
+			avg.Threads = int64(process.Threads)
+			avg.OpenFds = int64(process.OpenFDs)
+			avg.Container = convertContainer(process.Container)
+			avg.CpuPercent += process.CPUPercent
+			avg.ReadBytesPerSec += process.ReadBytesPerSec
+			avg.WriteBytesPerSec += process.WriteBytesPerSec
+		}
+	}
+	processes := make([]*collectorpb.ProcessUsage, 0, len(avgProcess))
+	for pid, avg := range avgProcess {
+		avg.CpuPercent /= samples[pid]
+		avg.ReadBytesPerSec /= samples[pid]
+		avg.WriteBytesPerSec /= samples[pid]
+		processes = append(processes, avg)
+	}
+	return processes
+}
+
+func averageWatchedProcesses(dataList []*collector.Collector) []*collectorpb.WatchedProcess {
+	var watched []*collectorpb.WatchedProcess
+	index := make(map[string]*collectorpb.WatchedProcess)
+	count := float64(len(dataList))
+	for _, metrics := range dataList {
+		for _, process := range metrics.WatchedProcess {
+			avg, ok := index[process.Name]
+			if !ok {
+				avg = &collectorpb.WatchedProcess{Name: process.Name}
+				index[process.Name] = avg
+				watched = append(watched, avg)
+			}
+			avg.Alive = process.Alive
+			avg.Pid = int64(process.PID)
+			avg.Pids = avg.Pids[:0]
+			for _, pid := range process.PIDs {
+				avg.Pids = append(avg.Pids, int64(pid))
+			}
+			avg.Restarts = int64(process.Restarts)
+			avg.RssBytes = int64(process.RSSBytes)
+			avg.SwapBytes = int64(process.SwapBytes)
+			avg.Threads = int64(process.Threads)
+			avg.OpenFds = int64(process.OpenFDs)
+			avg.CpuPercent += process.CPUPercent / count
+			avg.ReadBytesPerSec += process.ReadBytesPerSec / count
+			avg.WriteBytesPerSec += process.WriteBytesPerSec / count
+		}
+	}
+	return watched
+}
+
+func latestProcessStates(dataList []*collector.Collector) *collectorpb.ProcessStates {
+	if len(dataList) == 0 {
+		return &collectorpb.ProcessStates{}
+	}
+	states := dataList[len(dataList)-1].ProcessStates
+	zombies := make([]*collectorpb.ZombieProcess, 0, len(states.Zombies))
+	for _, zombie := range states.Zombies {
+		zombies = append(zombies, &collectorpb.ZombieProcess{
+			Pid:           int64(zombie.PID),
+			Ppid:          int64(zombie.PPID),
+			Command:       zombie.Command,
+			ParentCommand: zombie.ParentCommand,
+		})
+	}
+	return &collectorpb.ProcessStates{
+		Total:               int64(states.Total),
+		Running:             int64(states.Running),
+		Sleeping:            int64(states.Sleeping),
+		DiskSleep:           int64(states.DiskSleep),
+		Stopped:             int64(states.Stopped),
+		Zombie:              int64(states.Zombie),
+		Idle:                int64(states.Idle),
+		Threads:             int64(states.Threads),
+		PidMax:              int64(states.PIDMax),
+		ThreadsMax:          int64(states.ThreadsMax),
+		PidUsagePercent:     states.PIDUsagePercent,
+		ThreadsUsagePercent: states.ThreadsUsagePercent,
+		Zombies:             zombies,
+	}
+}
+
+func processSortValue(process *collectorpb.ProcessUsage, key collectorpb.ProcessSortKey) float64 {
+	switch key {
+	case collectorpb.ProcessSortKey_PROCESS_SORT_MEMORY:
+		return float64(process.RssBytes)
+	case collectorpb.ProcessSortKey_PROCESS_SORT_SWAP:
+		return float64(process.SwapBytes)
+	case collectorpb.ProcessSortKey_PROCESS_SORT_READ:
+		return process.ReadBytesPerSec
+	case collectorpb.ProcessSortKey_PROCESS_SORT_WRITE:
+		return process.WriteBytesPerSec
+	case collectorpb.ProcessSortKey_PROCESS_SORT_THREADS:
+		return float64(process.Threads)
+	case collectorpb.ProcessSortKey_PROCESS_SORT_FDS:
+		return float64(process.OpenFds)
+	case collectorpb.ProcessSortKey_PROCESS_SORT_CPU:
+		return process.CpuPercent
+	}
+	return process.CpuPercent
+}
+
+func topProcesses(processes []*collectorpb.ProcessUsage, key collectorpb.ProcessSortKey, top int) []*collectorpb.ProcessUsage {
+	if top <= 0 {
+		return nil
+	}
+	sort.Slice(processes, func(i, j int) bool {
+		left, right := processSortValue(processes[i], key), processSortValue(processes[j], key)
+		if left == right {
+			return processes[i].Pid < processes[j].Pid
+		}
+		return left > right
 	})
+	if len(processes) > top {
+		processes = processes[:top]
+	}
+	return processes
+}
+
+func averageCgroups(dataList []*collector.Collector) []*collectorpb.CgroupUsage {
+	var cgroups []*collectorpb.CgroupUsage
+	index := make(map[string]*collectorpb.CgroupUsage)
+	count := float64(len(dataList))
+	for _, metrics := range dataList {
+		for _, cgroup := range metrics.CgroupUsage {
+			avg, ok := index[cgroup.Path]
+			if !ok {
+				avg = &collectorpb.CgroupUsage{Path: cgroup.Path}
+				index[cgroup.Path] = avg
+				cgroups = append(cgroups, avg)
+			}
+			avg.CpuPercent += cgroup.CPUPercent / count
+			avg.CpuUserPercent += cgroup.CPUUserPercent / count
+			avg.CpuSystemPercent += cgroup.CPUSystemPercent / count
+			avg.ThrottledPercent += cgroup.ThrottledPercent / count
+			avg.ReadBytesPerSec += cgroup.ReadBytesPerSec / count
+			avg.WriteBytesPerSec += cgroup.WriteBytesPerSec / count
+			avg.ReadIops += cgroup.ReadIOPS / count
+			avg.WriteIops += cgroup.WriteIOPS / count
+			avg.MemoryBytes = int64(cgroup.MemoryBytes)
+			avg.MemoryAnon = int64(cgroup.MemoryAnon)
+			avg.MemoryFile = int64(cgroup.MemoryFile)
+			avg.MemoryKernel = int64(cgroup.MemoryKernel)
+			avg.MemoryShmem = int64(cgroup.MemoryShmem)
+			avg.Pids = int64(cgroup.Pids)
+			avg.Pressure = convertPressure(cgroup.Pressure)
+			avg.Container = convertContainer(cgroup.Container)
+		}
+	}
+	return cgroups
+}
+
+func latestNamespaces(dataList []*collector.Collector) []*collectorpb.NetworkNamespace {
+	if len(dataList) == 0 {
+		return nil
+	}
+	namespaces := dataList[len(dataList)-1].Namespaces
+	converted := make([]*collectorpb.NetworkNamespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		ns := &collectorpb.NetworkNamespace{
+			Inode:       int64(namespace.Inode),
+			Pid:         int64(namespace.PID),
+			Host:        namespace.Host,
+			Container:   convertContainer(namespace.Container),
+			Connections: int64(len(namespace.Connections)),
+		}
+		for _, conn := range namespace.Connections {
+			ns.Trafficinfo = append(ns.Trafficinfo, &collectorpb.TrafficInfo{
+				Sourceip:   conn.SourceIP,
+				SourcePort: int64(conn.SourcePort),
+				Destip:     conn.DestIP,
+				DestPort:   int64(conn.DestPort),
+				Protocol:   conn.Protocol,
+				Bytes:      int64(conn.Bytes),
+				State:      conn.State,
+				Container:  convertContainer(conn.Container),
+			})
+		}
+		for _, socket := range namespace.Listening {
+			ns.Listeningsocket = append(ns.Listeningsocket, &collectorpb.ListeningSocket{
+				Command:   socket.Command,
+				Pid:       int64(socket.PID),
+				User:      socket.User,
+				Protocol:  socket.Protocol,
+				Port:      int64(socket.Port),
+				Container: convertContainer(socket.Container),
+			})
+		}
+		for _, state := range namespace.TCPStates {
+			ns.Tcpstates = append(ns.Tcpstates, &collectorpb.TCPStates{
+				State: state.State,
+				Count: int64(state.Count),
+			})
+		}
+		converted = append(converted, ns)
+	}
+	return converted
+}
+
+func latestFileDescriptors(dataList []*collector.Collector) *collectorpb.FileDescriptorUsage {
+	if len(dataList) == 0 {
+		return &collectorpb.FileDescriptorUsage{}
+	}
+	fds := dataList[len(dataList)-1].FileDescriptors
+	converted := &collectorpb.FileDescriptorUsage{
+		Allocated:    int64(fds.Allocated),
+		Max:          int64(fds.Max),
+		UsagePercent: fds.UsagePercent,
+		Inodes:       int64(fds.Inodes),
+		FreeInodes:   int64(fds.FreeInodes),
+		PidMax:       int64(fds.PIDMax),
+	}
+	for _, process := range fds.Processes {
+		converted.Processes = append(converted.Processes, &collectorpb.FileDescriptorProcess{
+			Pid:          int64(process.PID),
+			Command:      process.Command,
+			OpenFds:      int64(process.OpenFDs),
+			Limit:        int64(process.Limit),
+			UsagePercent: process.UsagePercent,
+			Container:    convertContainer(process.Container),
+		})
+	}
+	return converted
+}
+
+// latestInterfaces reports the counters of the last sample, counters are not averaged.
+func latestInterfaces(dataList []*collector.Collector) []*collectorpb.NetworkInterface {
+	if len(dataList) == 0 {
+		return nil
+	}
+	interfaces := dataList[len(dataList)-1].Interfaces
+	converted := make([]*collectorpb.NetworkInterface, 0, len(interfaces))
+	for _, iface := range interfaces {
+		converted = append(converted, &collectorpb.NetworkInterface{
+			Name:      iface.Name,
+			RxBytes:   iface.RxBytes,
+			RxPackets: iface.RxPackets,
+			RxErrors:  iface.RxErrors,
+			RxDropped: iface.RxDropped,
+			TxBytes:   iface.TxBytes,
+			TxPackets: iface.TxPackets,
+			TxErrors:  iface.TxErrors,
+			TxDropped: iface.TxDropped,
+		})
+	}
+	return converted
+}
+
+func averageMemory(dataList []*collector.Collector) *collectorpb.MemoryUsage {
+	avgMemory := &collectorpb.MemoryUsage{}
+	count := float64(len(dataList))
+	var used, free, available, buffers, cached, swapUsed float64
+	for _, metrics := range dataList {
+		used += float64(metrics.Memory.UsedBytes) / count
+		free += float64(metrics.Memory.FreeBytes) / count
+		available += float64(metrics.Memory.AvailableBytes) / count
+		buffers += float64(metrics.Memory.BuffersBytes) / count
+		cached += float64(metrics.Memory.CachedBytes) / count
+		swapUsed += float64(metrics.Memory.SwapUsedBytes) / count
+		avgMemory.UsedPercent += metrics.Memory.UsedPercent / count
+		avgMemory.TotalBytes = metrics.Memory.TotalBytes
+		avgMemory.SwapTotalBytes = metrics.Memory.SwapTotalBytes
+	}
+	avgMemory.UsedBytes = uint64(used)
+	avgMemory.FreeBytes = uint64(free)
+	avgMemory.AvailableBytes = uint64(available)
+	avgMemory.BuffersBytes = uint64(buffers)
+	avgMemory.CachedBytes = uint64(cached)
+	avgMemory.SwapUsedBytes = uint64(swapUsed)
+	return avgMemory
+}
+
+func averageSensors(dataList []*collector.Collector) *collectorpb.Sensors {
+	avgSensors := &collectorpb.Sensors{}
+	zones := make(map[string]*collectorpb.ThermalZone)
+	hwmon := make(map[string]*collectorpb.HwmonSensor)
+	freqs := make(map[string]*collectorpb.CPUFrequency)
+	count := float64(len(dataList))
+	for _, metrics := range dataList {
+		for _, zone := range metrics.Sensors.ThermalZones {
+			avg, ok := zones[zone.Zone]
+			if !ok {
+				avg = &collectorpb.ThermalZone{Zone: zone.Zone, Type: zone.Type}
+				zones[zone.Zone] = avg
+				avgSensors.ThermalZones = append(avgSensors.ThermalZones, avg)
+			}
+			avg.Celsius += zone.Celsius / count
+		}
+		for _, sensor := range metrics.Sensors.Hwmon {
+			key := sensor.Device + "/" + sensor.Sensor
+			avg, ok := hwmon[key]
+			if !ok {
+				avg = &collectorpb.HwmonSensor{Chip: sensor.Chip, Device: sensor.Device, Sensor: sensor.Sensor, Label: sensor.Label, Kind: sensor.Kind}
+				hwmon[key] = avg
+				avgSensors.Hwmon = append(avgSensors.Hwmon, avg)
+			}
+			avg.Value += sensor.Value / count
+		}
+		for _, freq := range metrics.Sensors.CPUFrequency {
+			avg, ok := freqs[freq.CPU]
+			if !ok {
+				avg = &collectorpb.CPUFrequency{Cpu: freq.CPU}
+				freqs[freq.CPU] = avg
+				avgSensors.CpuFrequency = append(avgSensors.CpuFrequency, avg)
+			}
+			avg.CurrentMhz += freq.CurrentMHz / count
+			avg.MinMhz = freq.MinMHz
+			avg.MaxMhz = freq.MaxMHz
+			avg.CoreThrottles = int64(freq.CoreThrottles)
+			avg.PackageThrottles = int64(freq.PackageThrottles)
+		}
+	}
+	return avgSensors
+}
+
+// averageStatsD averages every series over the samples that carry it. Samples repeat the same
+// flush interval until the next one, so this weighs intervals by how much of the window they cover.
+func averageStatsD(dataList []*collector.Collector) *collectorpb.StatsD {
+	avgStatsD := &collectorpb.StatsD{}
+	series := make(map[string]*collectorpb.StatsDMetric)
+	counts := make(map[string]float64)
+	for _, metrics := range dataList {
+		for _, metric := range metrics.StatsD.Metrics {
+			key := metric.Type + "|" + metric.Name + "|" + strings.Join(metric.Tags, ",")
+			avg, ok := series[key]
+			if !ok {
+				avg = &collectorpb.StatsDMetric{Name: metric.Name, Type: metric.Type, Tags: metric.Tags}
+				series[key] = avg
+				avgStatsD.Metrics = append(avgStatsD.Metrics, avg)
+			}
+			counts[key]++
+			avg.Value += metric.Value
+			avg.Rate += metric.Rate
+			avg.Count += metric.Count
+			avg.Min += metric.Min
+			avg.Max += metric.Max
+			avg.Mean += metric.Mean
+			avg.Sum += metric.Sum
+			avg.P50 += metric.P50
+			avg.P90 += metric.P90
+			avg.P95 += metric.P95
+			avg.P99 += metric.P99
+		}
+		avgStatsD.Dropped = metrics.StatsD.Dropped
+	}
+	for key, avg := range series {
+		count := counts[key]
+		avg.Value /= count
+		avg.Rate /= count
+		avg.Count = uint64(float64(avg.Count) / count)
+		avg.Min /= count
+		avg.Max /= count
+		avg.Mean /= count
+		avg.Sum /= count
+		avg.P50 /= count
+		avg.P90 /= count
+		avg.P95 /= count
+		avg.P99 /= count
+	}
+	return avgStatsD
+}
+
+func convertContainer(identity collector.ContainerIdentity) *collectorpb.ContainerIdentity {
+	if identity == (collector.ContainerIdentity{}) {
+		return nil
+	}
+	return &collectorpb.ContainerIdentity{
+		Runtime:     identity.Runtime,
+		ContainerId: identity.ContainerID,
+		PodUid:      identity.PodUID,
+		QosClass:    identity.QoSClass,
+		Unit:        identity.Unit,
+	}
+}
+
+func convertPressure(pressure []collector.Pressure) []*collectorpb.Pressure {
+	converted := make([]*collectorpb.Pressure, 0, len(pressure))
+	for _, p := range pressure {
+		converted = append(converted, &collectorpb.Pressure{
+			Resource:   p.Resource,
+			SomeAvg10:  p.SomeAvg10,
+			SomeAvg60:  p.SomeAvg60,
+			SomeAvg300: p.SomeAvg300,
+			FullAvg10:  p.FullAvg10,
+			FullAvg60:  p.FullAvg60,
+			FullAvg300: p.FullAvg300,
+		})
+	}
+	return converted
+}
+
+func StartServer(cfg *config.Config, grpcport string) {
+	rules := make([]collector.WatchRule, 0, len(cfg.Watchlist))
+	for _, rule := range cfg.Watchlist {
+		rules = append(rules, collector.WatchRule{
+			Name:    rule.Name,
+			Comm:    rule.Comm,
+			Cmdline: rule.Cmdline,
+			Pidfile: rule.Pidfile,
+			Cgroup:  rule.Cgroup,
+		})
+	}
+	watchlist, err := collector.NewWatchlist(rules)
+	if err != nil {
+		panic("Invalid watchlist: " + err.Error())
+	}
+
+	var events *collector.ProcEvents
+	if cfg.Metrics.EnableProcessEvents {
+		events, err = collector.NewProcEvents(cfg.Processes.EventHistory)
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			go events.Run()
+			defer events.Close()
+		}
+	}
+
+	var statsd *collector.StatsD
+	if cfg.Metrics.EnableStatsD {
+		statsd, err = collector.NewStatsD(cfg.StatsD.Listen, cfg.StatsD.Socket,
+			time.Duration(cfg.StatsD.Interval)*time.Second, cfg.StatsD.MaxMetrics)
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			go statsd.Run()
+			defer statsd.Close()
+		}
+	}
+
+	options := collector.Options{
+		LoadAverage:     cfg.Metrics.EnableLoadAverage,
+		CPU:             cfg.Metrics.EnableCPU,
+		Memory:          cfg.Metrics.EnableMemory,
+		Disk:            cfg.Metrics.EnableDiskUsage,
+		FileSystems:     cfg.Metrics.EnableFileSystemUsage,
+		Network:         cfg.Metrics.EnableNetworkProtocol,
+		KernelActivity:  cfg.Metrics.EnableKernelActivity,
+		Interrupts:      cfg.Metrics.EnableInterrupts,
+		Processes:       cfg.Metrics.EnableProcesses,
+		ProcessStates:   cfg.Metrics.EnableProcessStates,
+		Watchlist:       watchlist,
+		ProcEvents:      events,
+		Cgroups:         cfg.Metrics.EnableCgroups,
+		CgroupDepth:     cfg.Cgroups.Depth,
+		CgroupInclude:   cfg.Cgroups.Include,
+		Namespaces:      cfg.Metrics.EnableNamespaces,
+		FileDescriptors: cfg.Metrics.EnableFileDescriptors,
+		FDThreshold:     cfg.FileDescriptors.Threshold,
+		Sensors:         cfg.Metrics.EnableSensors,
+		StatsD:          statsd,
+	}
+	sampler := newSampler(options, time.Duration(cfg.Server.Retention)*time.Second)
+	hostname, _ := os.Hostname()
+	maxInterval := time.Duration(cfg.Server.MaxInterval) * time.Second
+	if maxInterval <= 0 {
+		maxInterval = sampler.retention
+	}
+	families := make(familySet)
+	for _, family := range EnabledFamilies(cfg) {
+		families[family] = true
+	}
+	metricsServer := &MetricsCollectorServer{
+		options:  options,
+		families: families,
+		sampler:  sampler,
+		hostname: hostname,
+
+		minInterval: max(time.Duration(cfg.Server.MinInterval)*time.Second, time.Second),
+		maxInterval: maxInterval,
+		defaults: selection{
+			families:     families,
+			processSort:  collectorpb.ProcessSortKey(collectorpb.ProcessSortKey_value["PROCESS_SORT_"+strings.ToUpper(cfg.Processes.SortBy)]),
+			topProcesses: cfg.Processes.Top,
+		},
+	}
+
+	if cfg.Storage.Path != "" {
+		store, err := storage.Open(storage.Options{
+			Path:         cfg.Storage.Path,
+			MaxSize:      cfg.Storage.MaxSize,
+			Tiers:        storageTiers(cfg),
+			SyncInterval: cfg.Storage.SyncInterval,
+		})
+		if err != nil {
+			slog.Error(err.Error())
+		} else {
+			defer store.Close()
+			// Every process goes to disk, so a query can ask for a larger top than the default.
+			stored := metricsServer.defaults
+			stored.topProcesses = math.MaxInt32
+			metricsServer.history = newHistory(metricsServer, store, stored)
+			sampler.observe = metricsServer.history.add
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go sampler.run(ctx)
+	metricsServer.startExporters(ctx, cfg)
+	if cfg.Gateway.Listen != "" {
+		go func() {
+			if err := http.ListenAndServe(cfg.Gateway.Listen, metricsServer.gateway(cfg.Gateway.Dashboard)); err != nil {
+				slog.Error("gateway stopped", "error", err)
+			}
+		}()
+	}
+
+	server := grpc.NewServer()
+	collectorpb.RegisterMetricsCollectorServer(server, metricsServer)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", grpcport))
 	if err != nil {
@@ -0,0 +1,151 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// socketStream adapts a WebSocket to the CollectMetrics stream, every response is a text frame.
+type socketStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ws  *websocket.Conn
+}
+
+func (s *socketStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *socketStream) Send(resp *collectorpb.MetricsResponse) error {
+	data, err := protojson.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	return websocket.Message.Send(s.ws, string(data))
+}
+
+// sameOrigin refuses browsers on other sites, clients that send no Origin are let through.
+func sameOrigin(config *websocket.Config, r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	parsed, err := websocket.Origin(config, r)
+	if err != nil {
+		return err
+	}
+	if parsed == nil || !strings.EqualFold(parsed.Host, r.Host) {
+		return fmt.Errorf("origin %s is not allowed", origin)
+	}
+	return nil
+}
+
+// socketParams turns a JSON object sent by the client into query parameters, so a client can
+// switch to another interval, window or set of families without reconnecting.
+func socketParams(message string) (map[string][]string, error) {
+	var params map[string]any
+	if err := json.Unmarshal([]byte(message), &params); err != nil {
+		return nil, err
+	}
+	values := make(map[string][]string, len(params))
+	for name, param := range params {
+		switch param := param.(type) {
+		case string:
+			values[name] = []string{param}
+		case float64:
+			values[name] = []string{strconv.FormatFloat(param, 'f', -1, 64)}
+		case bool:
+			values[name] = []string{strconv.FormatBool(param)}
+		case []any:
+			items := make([]string, 0, len(param))
+			for _, item := range param {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[name] = []string{strings.Join(items, ",")}
+		default:
+			return nil, fmt.Errorf("unsupported value for %s", name)
+		}
+	}
+	return values, nil
+}
+
+// gatewayWebSocket streams metrics like /api/v1/metrics over a WebSocket. A text frame holding a
+// JSON object of the same parameters restarts the stream with them. A frame that does not parse
+// is answered with an error frame, an error of the stream itself is sent before closing.
+func (s *MetricsCollectorServer) gatewayWebSocket(w http.ResponseWriter, r *http.Request) {
+	q := &query{values: r.URL.Query()}
+	req := s.metricsRequest(q)
+	if q.err != nil {
+		writeError(w, q.err)
+		return
+	}
+	server := websocket.Server{
+		Handshake: sameOrigin,
+		Handler:   func(ws *websocket.Conn) { s.serveWebSocket(ws, req) },
+	}
+	server.ServeHTTP(w, r)
+}
+
+func (s *MetricsCollectorServer) serveWebSocket(ws *websocket.Conn, req *collectorpb.MetricsRequest) {
+	defer ws.Close()
+	ctx, cancel := context.WithCancel(ws.Request().Context())
+	defer cancel()
+
+	requests := make(chan *collectorpb.MetricsRequest)
+	go func() {
+		// The connection is gone once a read fails, which ends the stream as well.
+		defer cancel()
+		for {
+			var message string
+			if err := websocket.Message.Receive(ws, &message); err != nil {
+				return
+			}
+			values, err := socketParams(message)
+			if err != nil {
+				websocket.Message.Send(ws, `{"error":`+string(errorBody(fmt.Errorf("invalid parameters: %w", err)))+`}`)
+				continue
+			}
+			q := &query{values: values}
+			next := s.metricsRequest(q)
+			if q.err != nil {
+				websocket.Message.Send(ws, `{"error":`+string(errorBody(q.err))+`}`)
+				continue
+			}
+			select {
+			case requests <- next:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		streamCtx, stop := context.WithCancel(ctx)
+		done := make(chan error, 1)
+		go func(req *collectorpb.MetricsRequest) {
+			done <- s.CollectMetrics(req, &socketStream{ctx: streamCtx, ws: ws})
+		}(req)
+		select {
+		case next := <-requests:
+			stop()
+			<-done
+			req = next
+		case err := <-done:
+			stop()
+			if err != nil && ctx.Err() == nil && !errors.Is(err, context.Canceled) {
+				websocket.Message.Send(ws, `{"error":`+string(errorBody(err))+`}`)
+			}
+			return
+		}
+	}
+}
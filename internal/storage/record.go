@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Records are framed as a little endian payload length, the CRC32C of the payload and the
+// marshalled point, so a torn write at the end of the log is detected on recovery.
+const (
+	headerSize    = 8
+	maxRecordSize = 64 << 20
+)
+
+var (
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+	errCorrupt = errors.New("corrupt record")
+)
+
+func encodeRecord(point *collectorpb.MetricsResponse) ([]byte, error) {
+	payload, err := proto.Marshal(point)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal point: %w", err)
+	}
+	record := make([]byte, headerSize+len(payload))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(payload, castagnoli))
+	copy(record[headerSize:], payload)
+	return record, nil
+}
+
+// readRecords decodes records until the end of r and returns how many bytes were valid,
+// anything after a short or corrupt record is reported as errCorrupt.
+func readRecords(r io.Reader, fn func(*collectorpb.MetricsResponse)) (int64, error) {
+	reader := bufio.NewReader(r)
+	var (
+		valid  int64
+		header [headerSize]byte
+	)
+	for {
+		if _, err := io.ReadFull(reader, header[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return valid, nil
+			}
+			return valid, errCorrupt
+		}
+		size := binary.LittleEndian.Uint32(header[0:4])
+		if size > maxRecordSize {
+			return valid, errCorrupt
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			return valid, errCorrupt
+		}
+		if crc32.Checksum(payload, castagnoli) != binary.LittleEndian.Uint32(header[4:8]) {
+			return valid, errCorrupt
+		}
+		point := &collectorpb.MetricsResponse{}
+		if err := proto.Unmarshal(payload, point); err != nil {
+			return valid, errCorrupt
+		}
+		fn(point)
+		valid += int64(headerSize + len(payload))
+	}
+}
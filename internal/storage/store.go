@@ -0,0 +1,513 @@
+// Package storage keeps metric points on local disk. Every tier appends to a write-ahead
+// log that is sealed into a compressed block once it spans the tier's block duration.
+package storage
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
+)
+
+const (
+	TierRaw         = "raw"
+	TierMinute      = "1m"
+	TierFiveMinutes = "5m"
+	TierHour        = "1h"
+
+	walName     = "wal"
+	blockSuffix = ".blk"
+)
+
+type Tier struct {
+	Name       string
+	Resolution time.Duration
+	Retention  time.Duration
+	// BlockSpan is how much time the log covers before it is sealed into a block.
+	BlockSpan time.Duration
+}
+
+// DefaultTiers lists the raw tier and the rollups, finest first.
+func DefaultTiers() []Tier {
+	return []Tier{
+		{Name: TierRaw, Resolution: 0, Retention: 6 * time.Hour, BlockSpan: time.Hour},
+		{Name: TierMinute, Resolution: time.Minute, Retention: 7 * 24 * time.Hour, BlockSpan: 24 * time.Hour},
+		{Name: TierFiveMinutes, Resolution: 5 * time.Minute, Retention: 30 * 24 * time.Hour, BlockSpan: 7 * 24 * time.Hour},
+		{Name: TierHour, Resolution: time.Hour, Retention: 365 * 24 * time.Hour, BlockSpan: 30 * 24 * time.Hour},
+	}
+}
+
+type Options struct {
+	Path string
+	// MaxSize bounds the bytes of all tiers together, zero leaves only the age limits.
+	MaxSize int64
+	Tiers   []Tier
+	// SyncInterval is how often appended points are synced to disk, a crash loses at most the
+	// points of one interval. Zero means one second, a negative interval syncs every point.
+	SyncInterval time.Duration
+}
+
+type block struct {
+	path  string
+	start time.Time
+	end   time.Time
+	size  int64
+}
+
+type tier struct {
+	Tier
+	dir      string
+	wal      *os.File
+	walSize  int64
+	walStart time.Time
+	walEnd   time.Time
+	// dirty is set while the log holds points that are not synced yet.
+	dirty  bool
+	blocks []block
+}
+
+type Store struct {
+	mu           sync.Mutex
+	maxSize      int64
+	syncInterval time.Duration
+	tiers        []*tier
+	done         chan struct{}
+	wg           sync.WaitGroup
+}
+
+func pointTime(point *collectorpb.MetricsResponse) time.Time {
+	return point.GetWindowEnd().AsTime()
+}
+
+func blockName(start, end time.Time) string {
+	return fmt.Sprintf("%d-%d%s", start.UnixNano(), end.UnixNano(), blockSuffix)
+}
+
+func parseBlockName(name string) (time.Time, time.Time, bool) {
+	from, to, ok := strings.Cut(strings.TrimSuffix(name, blockSuffix), "-")
+	if !ok || !strings.HasSuffix(name, blockSuffix) {
+		return time.Time{}, time.Time{}, false
+	}
+	start, err := strconv.ParseInt(from, 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := strconv.ParseInt(to, 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return time.Unix(0, start), time.Unix(0, end), true
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// Open loads every tier under opts.Path and recovers their logs, a log cut short by an
+// unclean shutdown is truncated after its last complete record.
+func Open(opts Options) (*Store, error) {
+	if len(opts.Tiers) == 0 {
+		opts.Tiers = DefaultTiers()
+	}
+	if opts.SyncInterval == 0 {
+		opts.SyncInterval = time.Second
+	}
+	store := &Store{maxSize: opts.MaxSize, syncInterval: opts.SyncInterval, done: make(chan struct{})}
+	for _, config := range opts.Tiers {
+		t := &tier{Tier: config, dir: filepath.Join(opts.Path, config.Name)}
+		if err := t.open(); err != nil {
+			store.Close()
+			return nil, err
+		}
+		store.tiers = append(store.tiers, t)
+	}
+	if err := store.enforceRetention(time.Now()); err != nil {
+		store.Close()
+		return nil, err
+	}
+	if store.syncInterval > 0 {
+		store.wg.Add(1)
+		go store.syncLoop()
+	}
+	return store, nil
+}
+
+func (s *Store) syncLoop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.Sync(); err != nil {
+				slog.Error("failed to sync metric log", "error", err)
+			}
+		}
+	}
+}
+
+// Sync writes the points appended since the last sync to disk.
+func (s *Store) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var errs []error
+	for _, t := range s.tiers {
+		errs = append(errs, t.sync())
+	}
+	return errors.Join(errs...)
+}
+
+func (t *tier) sync() error {
+	if !t.dirty {
+		return nil
+	}
+	if err := t.wal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync %s log: %w", t.Name, err)
+	}
+	t.dirty = false
+	return nil
+}
+
+func (t *tier) open() error {
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", t.dir, err)
+	}
+	entries, err := os.ReadDir(t.dir)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", t.dir, err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasSuffix(name, ".tmp") {
+			// A block that was being written when the daemon died, its records are still in the log.
+			os.Remove(filepath.Join(t.dir, name))
+			continue
+		}
+		start, end, ok := parseBlockName(name)
+		if !ok {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", name, err)
+		}
+		t.blocks = append(t.blocks, block{path: filepath.Join(t.dir, name), start: start, end: end, size: info.Size()})
+	}
+	sort.Slice(t.blocks, func(i, j int) bool {
+		return t.blocks[i].start.Before(t.blocks[j].start)
+	})
+	return t.recover()
+}
+
+func (t *tier) recover() error {
+	wal, err := os.OpenFile(filepath.Join(t.dir, walName), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s log: %w", t.Name, err)
+	}
+	t.wal = wal
+
+	var sealed time.Time
+	if len(t.blocks) > 0 {
+		sealed = t.blocks[len(t.blocks)-1].end
+	}
+	var points []*collectorpb.MetricsResponse
+	valid, err := readRecords(wal, func(point *collectorpb.MetricsResponse) {
+		points = append(points, point)
+	})
+	if err != nil {
+		slog.Warn("truncating damaged metric log", "tier", t.Name, "offset", valid)
+	}
+	// Points up to the end of the last block were sealed before the log could be cleared.
+	kept := points[:0]
+	for _, point := range points {
+		if pointTime(point).After(sealed) {
+			kept = append(kept, point)
+		}
+	}
+	if err != nil || len(kept) < len(points) {
+		return t.rewrite(kept)
+	}
+	t.walSize = valid
+	if len(kept) > 0 {
+		t.walStart, t.walEnd = pointTime(kept[0]), pointTime(kept[len(kept)-1])
+	}
+	_, err = t.wal.Seek(valid, io.SeekStart)
+	return err
+}
+
+// rewrite replaces the log with points.
+func (t *tier) rewrite(points []*collectorpb.MetricsResponse) error {
+	if err := t.wal.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate %s log: %w", t.Name, err)
+	}
+	if _, err := t.wal.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	t.walSize, t.walStart, t.walEnd = 0, time.Time{}, time.Time{}
+	for _, point := range points {
+		if err := t.append(point); err != nil {
+			return err
+		}
+	}
+	t.dirty = true
+	return t.sync()
+}
+
+func (t *tier) append(point *collectorpb.MetricsResponse) error {
+	record, err := encodeRecord(point)
+	if err != nil {
+		return err
+	}
+	if _, err := t.wal.Write(record); err != nil {
+		return fmt.Errorf("failed to append to %s log: %w", t.Name, err)
+	}
+	if t.walSize == 0 {
+		t.walStart = pointTime(point)
+	}
+	t.walSize += int64(len(record))
+	t.walEnd = pointTime(point)
+	return nil
+}
+
+// seal compresses the log into a block. The block is renamed into place before the log is
+// cleared, so a crash in between leaves duplicates that recovery drops.
+func (t *tier) seal() error {
+	if t.walSize == 0 {
+		return nil
+	}
+	final := filepath.Join(t.dir, blockName(t.walStart, t.walEnd))
+	tmp := final + ".tmp"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create block: %w", err)
+	}
+	defer os.Remove(tmp)
+
+	compressed := gzip.NewWriter(out)
+	if _, err := t.wal.Seek(0, io.SeekStart); err != nil {
+		out.Close()
+		return err
+	}
+	if _, err := io.CopyN(compressed, t.wal, t.walSize); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to compress %s log: %w", t.Name, err)
+	}
+	if err := compressed.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	info, err := out.Stat()
+	out.Close()
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, final); err != nil {
+		return fmt.Errorf("failed to seal block: %w", err)
+	}
+	if err := syncDir(t.dir); err != nil {
+		return err
+	}
+	t.blocks = append(t.blocks, block{path: final, start: t.walStart, end: t.walEnd, size: info.Size()})
+	return t.rewrite(nil)
+}
+
+func (t *tier) size() int64 {
+	total := t.walSize
+	for _, b := range t.blocks {
+		total += b.size
+	}
+	return total
+}
+
+func (t *tier) dropOldest() error {
+	if err := os.Remove(t.blocks[0].path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove block: %w", err)
+	}
+	t.blocks = t.blocks[1:]
+	return nil
+}
+
+func (s *Store) tier(name string) (*tier, error) {
+	for _, t := range s.tiers {
+		if t.Name == name {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown tier %q", name)
+}
+
+// Tiers returns the configured tiers, finest first.
+func (s *Store) Tiers() []Tier {
+	tiers := make([]Tier, 0, len(s.tiers))
+	for _, t := range s.tiers {
+		tiers = append(tiers, t.Tier)
+	}
+	return tiers
+}
+
+// Append writes a point to the log of a tier, points must come in time order. The point is
+// synced with the next tick of SyncInterval, sealing a block syncs right away.
+func (s *Store) Append(name string, point *collectorpb.MetricsResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.tier(name)
+	if err != nil {
+		return err
+	}
+	if t.walSize > 0 && pointTime(point).Sub(t.walStart) >= t.BlockSpan {
+		if err := t.seal(); err != nil {
+			return err
+		}
+		if err := s.enforceRetention(pointTime(point)); err != nil {
+			return err
+		}
+	}
+	if err := t.append(point); err != nil {
+		return err
+	}
+	t.dirty = true
+	if s.syncInterval < 0 {
+		return t.sync()
+	}
+	return nil
+}
+
+// enforceRetention drops blocks past their tier's age, then the oldest blocks of the finest
+// tiers until the store fits MaxSize.
+func (s *Store) enforceRetention(now time.Time) error {
+	var total int64
+	for _, t := range s.tiers {
+		for len(t.blocks) > 0 && t.Retention > 0 && now.Sub(t.blocks[0].end) > t.Retention {
+			if err := t.dropOldest(); err != nil {
+				return err
+			}
+		}
+		total += t.size()
+	}
+	if s.maxSize <= 0 {
+		return nil
+	}
+	for _, t := range s.tiers {
+		for len(t.blocks) > 0 && total > s.maxSize {
+			total -= t.blocks[0].size
+			if err := t.dropOldest(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readBlock(path string, fn func(*collectorpb.MetricsResponse)) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	compressed, err := gzip.NewReader(in)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer compressed.Close()
+	if _, err := readRecords(compressed, fn); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return nil
+}
+
+// Query returns the points of a tier collected from start up to and including end. The log is
+// read under the lock, the blocks are decompressed after it is released.
+func (s *Store) Query(name string, start, end time.Time) ([]*collectorpb.MetricsResponse, error) {
+	inRange := func(points *[]*collectorpb.MetricsResponse) func(*collectorpb.MetricsResponse) {
+		return func(point *collectorpb.MetricsResponse) {
+			if at := pointTime(point); !at.Before(start) && !at.After(end) {
+				*points = append(*points, point)
+			}
+		}
+	}
+
+	s.mu.Lock()
+	t, err := s.tier(name)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	var blocks []block
+	for _, b := range t.blocks {
+		if !b.end.Before(start) && !b.start.After(end) {
+			blocks = append(blocks, b)
+		}
+	}
+	var logged []*collectorpb.MetricsResponse
+	if t.walSize > 0 && !t.walEnd.Before(start) && !t.walStart.After(end) {
+		// A section reader leaves the offset that appends write at alone.
+		_, err = readRecords(io.NewSectionReader(t.wal, 0, t.walSize), inRange(&logged))
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s log: %w", name, err)
+	}
+
+	var points []*collectorpb.MetricsResponse
+	for _, b := range blocks {
+		// Retention may drop a block once the lock is released, its points are past keeping.
+		if err := readBlock(b.path, inRange(&points)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return append(points, logged...), nil
+}
+
+// Oldest returns the time of the first point the tier still holds.
+func (s *Store) Oldest(name string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.tier(name)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if len(t.blocks) > 0 {
+		return t.blocks[0].start, true
+	}
+	return t.walStart, t.walSize > 0
+}
+
+// Close stops the sync loop, then syncs and closes the logs.
+func (s *Store) Close() error {
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+	s.wg.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var errs []error
+	for _, t := range s.tiers {
+		if t.wal != nil {
+			errs = append(errs, t.sync(), t.wal.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
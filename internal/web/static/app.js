@@ -0,0 +1,292 @@
+"use strict";
+
+// Families the dashboard draws, only those the server has enabled are requested.
+const WANTED = ["CPU", "MEMORY", "DISK", "NETWORK_PROTOCOLS", "CONNECTIONS"];
+const TOP_CONNECTIONS = 10;
+const COLORS = ["#0969da", "#cf222e", "#1a7f37", "#9a6700", "#8250df", "#bc4c00", "#1b7c83"];
+
+const state = {
+  families: [],
+  window: 300,
+  points: [],
+  socket: null,
+};
+
+function step(window) {
+  // Keeps about sixty points on every chart.
+  return Math.max(1, Math.round(window / 60));
+}
+
+function formatBytes(value) {
+  const units = ["B", "KiB", "MiB", "GiB", "TiB"];
+  let unit = 0;
+  while (Math.abs(value) >= 1024 && unit < units.length - 1) {
+    value /= 1024;
+    unit++;
+  }
+  return value.toFixed(unit === 0 ? 0 : 1) + " " + units[unit];
+}
+
+function formatPercent(value) {
+  return value.toFixed(0) + "%";
+}
+
+function formatNumber(value) {
+  return value >= 100 ? value.toFixed(0) : value.toFixed(1);
+}
+
+// series returns the named lines of one chart for a response.
+const charts = {
+  cpu: {
+    format: formatPercent,
+    max: 100,
+    series(c) {
+      const cpu = c.cpuusage || {};
+      return { user: cpu.userMode || 0, system: cpu.systemMode || 0 };
+    },
+  },
+  memory: {
+    format: formatBytes,
+    series(c) {
+      if (!c.memory) {
+        return {};
+      }
+      const m = c.memory;
+      return {
+        used: Number(m.usedBytes || 0),
+        cached: Number(m.cachedBytes || 0) + Number(m.buffersBytes || 0),
+        free: Number(m.freeBytes || 0),
+        swap: Number(m.swapUsedBytes || 0),
+      };
+    },
+  },
+  disk: {
+    format: (value) => formatBytes(value * 1024) + "/s",
+    series(c) {
+      const lines = {};
+      for (const disk of c.diskusage || []) {
+        lines[disk.name] = disk.kbpersec || 0;
+      }
+      return lines;
+    },
+  },
+  network: {
+    format: formatBytes,
+    series(c) {
+      const lines = {};
+      for (const protocol of c.networkprotocol || []) {
+        lines[protocol.protocol] = Number(protocol.bytes || 0);
+      }
+      return lines;
+    },
+  },
+};
+
+function draw(id) {
+  const chart = charts[id];
+  const canvas = document.getElementById(id);
+  const ratio = window.devicePixelRatio || 1;
+  canvas.width = canvas.clientWidth * ratio;
+  canvas.height = canvas.clientHeight * ratio;
+  const ctx = canvas.getContext("2d");
+  ctx.scale(ratio, ratio);
+  const width = canvas.clientWidth;
+  const height = canvas.clientHeight;
+  const left = 64;
+  const bottom = height - 20;
+  const legend = 16;
+
+  const now = Date.now();
+  const start = now - state.window * 1000;
+  const lines = new Map();
+  let max = chart.max || 0;
+  for (const point of state.points) {
+    for (const [name, value] of Object.entries(chart.series(point.collector))) {
+      if (!lines.has(name)) {
+        lines.set(name, []);
+      }
+      lines.get(name).push([point.time, value]);
+      max = Math.max(max, value);
+    }
+  }
+  if (max === 0) {
+    max = 1;
+  }
+  const x = (time) => left + ((time - start) / (now - start)) * (width - left);
+  const y = (value) => bottom - (value / max) * (bottom - legend);
+
+  ctx.clearRect(0, 0, width, height);
+  ctx.font = "11px system-ui, sans-serif";
+  ctx.strokeStyle = "#e4e7eb";
+  ctx.fillStyle = "#656d76";
+  ctx.textBaseline = "middle";
+  for (let i = 0; i <= 4; i++) {
+    const value = (max * i) / 4;
+    ctx.beginPath();
+    ctx.moveTo(left, y(value));
+    ctx.lineTo(width, y(value));
+    ctx.stroke();
+    ctx.fillText(chart.format(value), 0, y(value));
+  }
+  ctx.textBaseline = "alphabetic";
+  ctx.fillText(state.window / 60 + " min ago", left, height - 4);
+  ctx.fillText("now", width - 24, height - 4);
+
+  let index = 0;
+  let legendX = left;
+  for (const [name, values] of lines) {
+    const color = COLORS[index++ % COLORS.length];
+    ctx.strokeStyle = color;
+    ctx.fillStyle = color;
+    ctx.lineWidth = 1.5;
+    ctx.beginPath();
+    values.forEach(([time, value], i) => {
+      if (i === 0) {
+        ctx.moveTo(x(time), y(value));
+      } else {
+        ctx.lineTo(x(time), y(value));
+      }
+    });
+    ctx.stroke();
+    ctx.lineWidth = 1;
+    const label = name + " " + chart.format(values[values.length - 1][1]);
+    ctx.fillText(label, legendX, 10);
+    legendX += ctx.measureText(label).width + 16;
+  }
+}
+
+function renderConnections(collector) {
+  const body = document.getElementById("connections");
+  body.replaceChildren();
+  for (const conn of collector.trafficinfo || []) {
+    const row = document.createElement("tr");
+    const cells = [
+      conn.protocol,
+      conn.sourceip + ":" + (conn.sourcePort || 0),
+      conn.destip + ":" + (conn.destPort || 0),
+      conn.State || "",
+      formatBytes(Number(conn.Bytes || 0)),
+    ];
+    cells.forEach((text, i) => {
+      const cell = document.createElement("td");
+      cell.textContent = text;
+      if (i === cells.length - 1) {
+        cell.className = "number";
+      }
+      row.appendChild(cell);
+    });
+    body.appendChild(row);
+  }
+}
+
+function render() {
+  const cutoff = Date.now() - state.window * 1000;
+  state.points = state.points.filter((point) => point.time >= cutoff);
+  for (const id of Object.keys(charts)) {
+    draw(id);
+  }
+  const last = state.points[state.points.length - 1];
+  if (last) {
+    renderConnections(last.collector);
+  }
+}
+
+function add(response) {
+  if (!response.collector) {
+    return;
+  }
+  const time = response.windowEnd ? Date.parse(response.windowEnd) : Date.now();
+  state.points.push({ time, collector: response.collector });
+}
+
+function setStatus(text, className) {
+  const status = document.getElementById("status");
+  status.textContent = text;
+  status.className = "status " + (className || "");
+}
+
+function params() {
+  const interval = step(state.window);
+  return {
+    interval: interval,
+    window: interval,
+    families: state.families.join(","),
+    top_connections: TOP_CONNECTIONS,
+  };
+}
+
+// backfill loads the window from history, a server without history just starts empty.
+async function backfill() {
+  const query = new URLSearchParams({
+    start: "-" + state.window + "s",
+    step: step(state.window) + "s",
+    families: state.families.join(","),
+    top_connections: TOP_CONNECTIONS,
+  });
+  state.points = [];
+  try {
+    const response = await fetch("api/v1/query?" + query);
+    if (response.ok) {
+      const body = await response.json();
+      (body.points || []).forEach(add);
+    }
+  } catch (err) {
+    console.warn("history is not available", err);
+  }
+  render();
+}
+
+function connect() {
+  const scheme = location.protocol === "https:" ? "wss:" : "ws:";
+  const url = new URL("api/v1/ws?" + new URLSearchParams(params()), location.href);
+  url.protocol = scheme;
+  const socket = new WebSocket(url);
+  state.socket = socket;
+  socket.onopen = () => setStatus("live", "live");
+  socket.onmessage = (event) => {
+    const message = JSON.parse(event.data);
+    if (message.error) {
+      setStatus(message.error.message || "error", "error");
+      return;
+    }
+    add(message);
+    render();
+  };
+  socket.onclose = () => {
+    if (state.socket !== socket) {
+      return;
+    }
+    setStatus("reconnecting", "error");
+    setTimeout(connect, 2000);
+  };
+}
+
+async function start() {
+  const host = await fetch("api/v1/host").then((response) => response.json()).catch(() => ({}));
+  if (host.hostname) {
+    document.getElementById("host").textContent = host.hostname;
+    document.title = host.hostname + " - System stats";
+  }
+  const capabilities = await fetch("api/v1/capabilities").then((response) => response.json());
+  const available = new Set(
+    (capabilities.families || [])
+      .filter((family) => family.enabled && family.supported)
+      .map((family) => family.family.replace("METRIC_FAMILY_", ""))
+  );
+  state.families = WANTED.filter((family) => available.has(family));
+
+  document.getElementById("window").addEventListener("change", async (event) => {
+    state.window = Number(event.target.value);
+    await backfill();
+    if (state.socket && state.socket.readyState === WebSocket.OPEN) {
+      state.socket.send(JSON.stringify(params()));
+    }
+  });
+  window.addEventListener("resize", render);
+
+  state.window = Number(document.getElementById("window").value);
+  await backfill();
+  connect();
+}
+
+start().catch((err) => setStatus(err.message, "error"));
@@ -0,0 +1,21 @@
+// Package web holds the dashboard the gateway serves. The files are built into the binary, the
+// page reads history from /api/v1/query and follows live metrics over /api/v1/ws.
+package web
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+//go:embed static
+var static embed.FS
+
+// Handler serves the dashboard files.
+func Handler() http.Handler {
+	files, err := fs.Sub(static, "static")
+	if err != nil {
+		panic(err)
+	}
+	return http.FileServer(http.FS(files))
+}
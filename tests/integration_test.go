@@ -1,26 +1,44 @@
 package collector_test
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/Gilfoyle3301/system-stats-daemon/api/client"
 	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
 	"github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/config"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/exporter"
 	"github.com/Gilfoyle3301/system-stats-daemon/internal/grpcserver"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metricsservice "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"golang.org/x/net/websocket"
 )
 
 func TestServerIntegration(t *testing.T) {
 	done := make(chan struct{})
 	ticker := time.NewTicker(3 * time.Second)
 	var lastResp *collectorpb.MetricsResponse
-	go grpcserver.StartServer(&collector.Collector{}, "12345")
+	go grpcserver.StartServer(&config.Config{}, "12345")
 	time.Sleep(2 * time.Second)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
@@ -58,3 +76,555 @@ func TestServerIntegration(t *testing.T) {
 	}
 	assert.NotNil(t, lastResp, "Expected to receive awt least one metrics response")
 }
+
+func TestGetHostInfo(t *testing.T) {
+	go grpcserver.StartServer(&config.Config{}, "12346")
+	time.Sleep(time.Second)
+	conn, err := grpc.NewClient("localhost:12346", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	resp, err := collectorpb.NewMetricsCollectorClient(conn).GetHostInfo(context.Background(), &collectorpb.HostInfoRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetHostname())
+	require.NotEmpty(t, resp.GetKernelRelease())
+	require.Positive(t, resp.GetCpuThreads())
+	require.Positive(t, resp.GetMemoryTotal())
+	require.NotNil(t, resp.GetBootTime())
+}
+
+func TestGetSnapshot(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableLoadAverage = true
+	cfg.Metrics.EnableCPU = true
+	cfg.Metrics.EnableFileSystemUsage = true
+	cfg.Metrics.EnableNetworkProtocol = true
+	go grpcserver.StartServer(cfg, "12347")
+	time.Sleep(time.Second)
+	conn, err := grpc.NewClient("localhost:12347", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	daemonClient := collectorpb.NewMetricsCollectorClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	latest, err := daemonClient.GetSnapshot(ctx, &collectorpb.SnapshotRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, latest.GetCollector().GetCpuusage())
+	require.NotEmpty(t, latest.GetCollector().GetFilesystemusage())
+
+	time.Sleep(3 * time.Second)
+	window, err := daemonClient.GetSnapshot(ctx, &collectorpb.SnapshotRequest{WindowSeconds: 3})
+	require.NoError(t, err)
+	require.NotNil(t, window.GetCollector().GetLoadaverage())
+	require.GreaterOrEqual(t, window.GetSamples(), int64(2))
+	require.Greater(t, window.GetSequence(), latest.GetSequence())
+	require.True(t, window.GetWindowEnd().AsTime().After(window.GetWindowStart().AsTime()))
+	require.NotEmpty(t, window.GetHostname())
+	require.NotEmpty(t, window.GetFamilyStatus())
+	for _, family := range window.GetFamilyStatus() {
+		require.Empty(t, family.GetError())
+	}
+
+	selected, err := daemonClient.GetSnapshot(ctx, &collectorpb.SnapshotRequest{
+		Families:       []collectorpb.MetricFamily{collectorpb.MetricFamily_METRIC_FAMILY_CPU, collectorpb.MetricFamily_METRIC_FAMILY_CONNECTIONS},
+		TopConnections: 1,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, selected.GetCollector().GetCpuusage())
+	require.Nil(t, selected.GetCollector().GetLoadaverage())
+	require.Empty(t, selected.GetCollector().GetFilesystemusage())
+	require.LessOrEqual(t, len(selected.GetCollector().GetTrafficinfo()), 1)
+
+	_, err = daemonClient.GetSnapshot(ctx, &collectorpb.SnapshotRequest{
+		Families: []collectorpb.MetricFamily{collectorpb.MetricFamily_METRIC_FAMILY_PROCESSES},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestSnapshotAverages checks the window reducers against fresh readings: every value is divided
+// by the sample count once and file systems are kept apart by mount point.
+func TestSnapshotAverages(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	cfg.Metrics.EnableFileSystemUsage = true
+	go grpcserver.StartServer(cfg, "12358")
+	time.Sleep(5 * time.Second)
+	conn, err := grpc.NewClient("localhost:12358", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	window, err := collectorpb.NewMetricsCollectorClient(conn).GetSnapshot(context.Background(), &collectorpb.SnapshotRequest{WindowSeconds: 3})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, window.GetSamples(), int64(2))
+
+	cpu := window.GetCollector().GetCpuusage()
+	require.InDelta(t, 100, cpu.GetUserMode()+cpu.GetSystemMode()+cpu.GetIdle(), 10)
+
+	expected := map[string]collector.FileSystemUsage{}
+	for _, fs := range collector.FsStat() {
+		expected[fs.MountPoint] = fs
+	}
+	seen := map[string]bool{}
+	for _, fs := range window.GetCollector().GetFilesystemusage() {
+		require.False(t, seen[fs.GetMountPoint()], "%s is listed twice", fs.GetMountPoint())
+		seen[fs.GetMountPoint()] = true
+		current, ok := expected[fs.GetMountPoint()]
+		require.True(t, ok, fs.GetMountPoint())
+		require.InDelta(t, current.UsedPercent, fs.GetUsedPercent(), 1, fs.GetMountPoint())
+	}
+	require.Len(t, seen, len(expected))
+}
+
+func TestGetCapabilities(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	go grpcserver.StartServer(cfg, "12348")
+	time.Sleep(time.Second)
+	conn, err := grpc.NewClient("localhost:12348", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	daemonClient := collectorpb.NewMetricsCollectorClient(conn)
+
+	capabilities, err := daemonClient.GetCapabilities(context.Background(), &collectorpb.CapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, capabilities.GetFamilies(), len(collectorpb.MetricFamily_name)-1)
+	require.Equal(t, time.Second, capabilities.GetMinInterval().AsDuration())
+	require.Equal(t, 300*time.Second, capabilities.GetMaxWindow().AsDuration())
+	for _, family := range capabilities.GetFamilies() {
+		require.Equal(t, family.GetFamily() == collectorpb.MetricFamily_METRIC_FAMILY_CPU, family.GetEnabled())
+		require.NotEmpty(t, family.GetFields(), family.GetFamily().String())
+	}
+	cpu := capabilities.GetFamilies()[collectorpb.MetricFamily_METRIC_FAMILY_CPU-1]
+	require.True(t, cpu.GetSupported())
+	require.Equal(t, "user_mode", cpu.GetFields()[0].GetName())
+	require.Equal(t, "percent", cpu.GetFields()[0].GetUnit())
+
+	// The values behind the unit stay within 0 and 100.
+	snapshot, err := daemonClient.GetSnapshot(context.Background(), &collectorpb.SnapshotRequest{})
+	require.NoError(t, err)
+	usage := snapshot.GetCollector().GetCpuusage()
+	require.NotNil(t, usage)
+	for _, value := range []float64{usage.GetUserMode(), usage.GetSystemMode(), usage.GetIdle()} {
+		require.GreaterOrEqual(t, value, 0.0)
+		require.LessOrEqual(t, value, 100.0)
+	}
+
+	stream, err := daemonClient.CollectMetrics(context.Background(), &collectorpb.MetricsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestDeltaStream(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableNetworkProtocol = true
+	go grpcserver.StartServer(cfg, "12349")
+	time.Sleep(time.Second)
+	conn, err := grpc.NewClient("localhost:12349", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	stream, err := collectorpb.NewMetricsCollectorClient(conn).CollectMetrics(ctx, &collectorpb.MetricsRequest{
+		NSecond:          1,
+		Delta:            true,
+		KeyframeInterval: 2,
+	})
+	require.NoError(t, err)
+	reader := client.NewDeltaReader(stream)
+	for i := 0; i < 3; i++ {
+		resp, err := reader.Recv()
+		require.NoError(t, err)
+		require.Equal(t, i%2 == 0, resp.GetKeyframe())
+		require.Nil(t, resp.GetDelta())
+		require.NotEmpty(t, resp.GetCollector().GetListeningsocket())
+	}
+}
+
+func TestQueryRange(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableLoadAverage = true
+	cfg.Metrics.EnableCPU = true
+	go grpcserver.StartServer(cfg, "12350")
+	start := time.Now()
+	time.Sleep(5 * time.Second)
+	conn, err := grpc.NewClient("localhost:12350", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	daemonClient := collectorpb.NewMetricsCollectorClient(conn)
+
+	end := timestamppb.Now()
+	raw, err := daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{
+		Start: timestamppb.New(start),
+		End:   end,
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(raw.GetPoints()), 3)
+	for i, point := range raw.GetPoints() {
+		require.Equal(t, int64(1), point.GetSamples())
+		require.NotNil(t, point.GetCollector().GetCpuusage())
+		if i > 0 {
+			require.Greater(t, point.GetSequence(), raw.GetPoints()[i-1].GetSequence())
+		}
+	}
+
+	stepped, err := daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{
+		Start:    timestamppb.New(start),
+		End:      end,
+		Step:     durationpb.New(time.Hour),
+		Families: []collectorpb.MetricFamily{collectorpb.MetricFamily_METRIC_FAMILY_LOAD_AVERAGE},
+	})
+	require.NoError(t, err)
+	require.Len(t, stepped.GetPoints(), 1)
+	require.Equal(t, int64(len(raw.GetPoints())), stepped.GetPoints()[0].GetSamples())
+	require.Nil(t, stepped.GetPoints()[0].GetCollector().GetCpuusage())
+
+	_, err = daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{
+		Start: timestamppb.New(time.Now().Add(-time.Hour)),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryRangeFromDisk(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	cfg.Metrics.EnableProcesses = true
+	cfg.Processes.Top = 1
+	cfg.Server.Retention = 2
+	cfg.Storage.Path = t.TempDir()
+	go grpcserver.StartServer(cfg, "12351")
+	start := time.Now()
+	time.Sleep(6 * time.Second)
+	conn, err := grpc.NewClient("localhost:12351", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	daemonClient := collectorpb.NewMetricsCollectorClient(conn)
+	end := timestamppb.Now()
+	resp, err := daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{
+		Start:        timestamppb.New(start),
+		End:          end,
+		TopProcesses: 1000,
+	})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(resp.GetPoints()), 5)
+	for _, point := range resp.GetPoints() {
+		require.NotNil(t, point.GetCollector().GetCpuusage())
+		// The disk keeps every process, not just the configured top.
+		require.Greater(t, len(point.GetCollector().GetProcessusage()), cfg.Processes.Top)
+	}
+
+	// Points rolled up from disk average every process over the bucket, not just the last point.
+	const step = 3 * time.Second
+	stepped, err := daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{
+		Start:        timestamppb.New(start),
+		End:          end,
+		Step:         durationpb.New(step),
+		TopProcesses: 1000,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stepped.GetPoints())
+	sums := map[int64]map[int64]float64{}
+	counts := map[int64]map[int64]int{}
+	for _, point := range resp.GetPoints() {
+		bucket := int64(point.GetWindowEnd().AsTime().Sub(start) / step)
+		if sums[bucket] == nil {
+			sums[bucket], counts[bucket] = map[int64]float64{}, map[int64]int{}
+		}
+		for _, process := range point.GetCollector().GetProcessusage() {
+			sums[bucket][process.GetPid()] += process.GetCpuPercent()
+			counts[bucket][process.GetPid()]++
+		}
+	}
+	for _, point := range stepped.GetPoints() {
+		bucket := int64(point.GetWindowEnd().AsTime().Sub(start) / step)
+		pids := map[int64]bool{}
+		for _, process := range point.GetCollector().GetProcessusage() {
+			require.False(t, pids[process.GetPid()], "process %d is listed twice", process.GetPid())
+			pids[process.GetPid()] = true
+			mean := sums[bucket][process.GetPid()] / float64(counts[bucket][process.GetPid()])
+			require.InDelta(t, mean, process.GetCpuPercent(), 1e-6, process.GetPid())
+		}
+		require.Len(t, pids, len(counts[bucket]))
+	}
+
+	_, err = daemonClient.QueryRange(context.Background(), &collectorpb.QueryRangeRequest{
+		Start: timestamppb.New(start.Add(-time.Hour)),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPrometheusExporter(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableLoadAverage = true
+	cfg.Metrics.EnableFileSystemUsage = true
+	cfg.Exporters.Prometheus.Listen = "localhost:19273"
+	go grpcserver.StartServer(cfg, "12352")
+	time.Sleep(2 * time.Second)
+
+	resp, err := http.Get("http://localhost:19273/metrics")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, exporter.ContentTypeText, resp.Header.Get("Content-Type"))
+	require.Contains(t, string(body), "# TYPE sysstats_load_average gauge")
+	require.Contains(t, string(body), `mountpoint="/"`)
+	require.NotContains(t, string(body), "sysstats_cpu_usage_ratio")
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:19273/metrics", nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	body, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, exporter.ContentTypeOpenMetrics, resp.Header.Get("Content-Type"))
+	require.True(t, strings.HasSuffix(string(body), "# EOF\n"))
+}
+
+type otlpReceiver struct {
+	metricsservice.UnimplementedMetricsServiceServer
+	received chan *metricsservice.ExportMetricsServiceRequest
+}
+
+func (r *otlpReceiver) Export(_ context.Context, req *metricsservice.ExportMetricsServiceRequest) (*metricsservice.ExportMetricsServiceResponse, error) {
+	select {
+	case r.received <- req:
+	default:
+	}
+	return &metricsservice.ExportMetricsServiceResponse{}, nil
+}
+
+func TestOTLPExporter(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	receiver := &otlpReceiver{received: make(chan *metricsservice.ExportMetricsServiceRequest, 1)}
+	server := grpc.NewServer()
+	metricsservice.RegisterMetricsServiceServer(server, receiver)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	cfg.Metrics.EnableMemory = true
+	cfg.Exporters.OTLP.Endpoint = lis.Addr().String()
+	cfg.Exporters.OTLP.Protocol = exporter.ProtocolGRPC
+	cfg.Exporters.OTLP.Insecure = true
+	cfg.Exporters.OTLP.Interval = 1
+	go grpcserver.StartServer(cfg, "12353")
+
+	select {
+	case req := <-receiver.received:
+		resource := req.GetResourceMetrics()[0]
+		attributes := make(map[string]string)
+		for _, attribute := range resource.GetResource().GetAttributes() {
+			attributes[attribute.GetKey()] = attribute.GetValue().GetStringValue()
+		}
+		require.NotEmpty(t, attributes["host.name"])
+		require.Equal(t, "linux", attributes["os.type"])
+
+		names := make(map[string]bool)
+		memoryStates := make(map[string]bool)
+		for _, metric := range resource.GetScopeMetrics()[0].GetMetrics() {
+			names[metric.GetName()] = true
+			switch metric.GetName() {
+			case "system.cpu.utilization":
+				for _, point := range metric.GetGauge().GetDataPoints() {
+					require.GreaterOrEqual(t, point.GetAsDouble(), 0.0)
+					require.LessOrEqual(t, point.GetAsDouble(), 1.0)
+				}
+			case "system.memory.usage":
+				require.False(t, metric.GetSum().GetIsMonotonic())
+				for _, point := range metric.GetSum().GetDataPoints() {
+					for _, attribute := range point.GetAttributes() {
+						if attribute.GetKey() == "system.memory.state" {
+							memoryStates[attribute.GetValue().GetStringValue()] = true
+						}
+					}
+				}
+			}
+		}
+		require.True(t, names["system.cpu.utilization"])
+		require.True(t, names["system.memory.limit"])
+		require.True(t, names["system.memory.utilization"])
+		require.Equal(t, map[string]bool{"used": true, "free": true, "buffers": true, "cached": true}, memoryStates)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the OTLP receiver got no metrics")
+	}
+}
+
+func TestStatsDListener(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableStatsD = true
+	cfg.StatsD.Listen = "127.0.0.1:18125"
+	cfg.StatsD.Interval = 1
+	go grpcserver.StartServer(cfg, "12354")
+	time.Sleep(time.Second)
+
+	statsd, err := net.Dial("udp", cfg.StatsD.Listen)
+	require.NoError(t, err)
+	defer statsd.Close()
+	conn, err := grpc.NewClient("localhost:12354", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	daemonClient := collectorpb.NewMetricsCollectorClient(conn)
+
+	require.Eventually(t, func() bool {
+		_, err := statsd.Write([]byte("checkout.orders:4|c|#region:eu\ncheckout.latency:12|ms"))
+		require.NoError(t, err)
+		resp, err := daemonClient.GetSnapshot(context.Background(), &collectorpb.SnapshotRequest{
+			Families: []collectorpb.MetricFamily{collectorpb.MetricFamily_METRIC_FAMILY_STATSD},
+		})
+		require.NoError(t, err)
+		for _, metric := range resp.GetCollector().GetStatsd().GetMetrics() {
+			if metric.GetName() == "checkout.orders" {
+				return metric.GetType() == "counter" && metric.GetTags()[0] == "region:eu" && metric.GetRate() > 0
+			}
+		}
+		return false
+	}, 10*time.Second, 500*time.Millisecond)
+}
+
+func TestRecordWithoutClient(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	cfg.Exporters.Record.Path = dir
+	cfg.Exporters.Record.Interval = 1
+	go grpcserver.StartServer(cfg, "12355")
+
+	require.Eventually(t, func() bool {
+		recorded, err := os.ReadFile(filepath.Join(dir, "metrics.jsonl"))
+		return err == nil && bytes.Contains(recorded, []byte(`"cpuusage"`))
+	}, 10*time.Second, 200*time.Millisecond)
+}
+
+func TestGateway(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	cfg.Metrics.EnableLoadAverage = true
+	cfg.Gateway.Listen = "localhost:18080"
+	go grpcserver.StartServer(cfg, "12356")
+	time.Sleep(time.Second)
+	const base = "http://localhost:18080/api/v1"
+
+	get := func(path, accept string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, base+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", accept)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := get("/host", "")
+	var host collectorpb.HostInfo
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, protojson.Unmarshal(body, &host))
+	require.NotEmpty(t, host.GetHostname())
+
+	resp = get("/snapshot?families=cpu&window=2s", "")
+	var snapshot collectorpb.MetricsResponse
+	body, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.NoError(t, protojson.Unmarshal(body, &snapshot))
+	require.NotNil(t, snapshot.GetCollector().GetCpuusage())
+	require.Nil(t, snapshot.GetCollector().GetLoadaverage())
+
+	resp = get("/snapshot?families=bogus", "")
+	body, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Contains(t, string(body), `"code":"InvalidArgument"`)
+
+	resp = get("/events", "")
+	resp.Body.Close()
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	resp = get("/metrics?interval=1&families=load_average", "text/event-stream")
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	reader := bufio.NewReader(resp.Body)
+	event, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: metrics\n", event)
+	data, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	var streamed collectorpb.MetricsResponse
+	require.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), &streamed))
+	require.NotNil(t, streamed.GetCollector().GetLoadaverage())
+	resp.Body.Close()
+
+	resp = get("/metrics?interval=1", "")
+	require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+	line, err := bufio.NewReader(resp.Body).ReadBytes('\n')
+	require.NoError(t, err)
+	require.NoError(t, protojson.Unmarshal(line, &streamed))
+	require.NotNil(t, streamed.GetCollector().GetCpuusage())
+	resp.Body.Close()
+}
+
+func TestDashboard(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Metrics.EnableCPU = true
+	cfg.Metrics.EnableMemory = true
+	cfg.Gateway.Listen = "localhost:18081"
+	cfg.Gateway.Dashboard = true
+	go grpcserver.StartServer(cfg, "12357")
+	time.Sleep(time.Second)
+
+	resp, err := http.Get("http://localhost:18081/")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(body), `<script src="app.js">`)
+
+	resp, err = http.Get("http://localhost:18081/app.js")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = websocket.Dial("ws://localhost:18081/api/v1/ws", "", "http://elsewhere.example")
+	require.Error(t, err)
+
+	ws, err := websocket.Dial("ws://localhost:18081/api/v1/ws?interval=1&families=memory", "", "http://localhost:18081")
+	require.NoError(t, err)
+	defer ws.Close()
+	receive := func() *collectorpb.MetricsResponse {
+		ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var message string
+		require.NoError(t, websocket.Message.Receive(ws, &message))
+		var resp collectorpb.MetricsResponse
+		require.NoError(t, protojson.Unmarshal([]byte(message), &resp), message)
+		return &resp
+	}
+	streamed := receive()
+	require.NotZero(t, streamed.GetCollector().GetMemory().GetTotalBytes())
+	require.Nil(t, streamed.GetCollector().GetCpuusage())
+
+	require.NoError(t, websocket.Message.Send(ws, `{"interval":1,"families":["cpu"]}`))
+	require.Eventually(t, func() bool {
+		return receive().GetCollector().GetCpuusage() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, websocket.Message.Send(ws, `{"families":"bogus"}`))
+	require.Eventually(t, func() bool {
+		ws.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var message string
+		require.NoError(t, websocket.Message.Receive(ws, &message))
+		return strings.Contains(message, `"error"`)
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -2,13 +2,33 @@ package collector_test
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/Gilfoyle3301/system-stats-daemon/api/client"
+	collectorpb "github.com/Gilfoyle3301/system-stats-daemon/api/pb"
 	collector "github.com/Gilfoyle3301/system-stats-daemon/internal/collector"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/exporter"
+	"github.com/Gilfoyle3301/system-stats-daemon/internal/storage"
 	"github.com/stretchr/testify/require"
+	metricsservice "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestUnitPackage(t *testing.T) {
@@ -35,25 +55,33 @@ func TestUnitPackage(t *testing.T) {
 		testData, err := collector.CpuStat()
 		require.NoError(t, err)
 
-		cpu, err := os.Open("/proc/stat")
-		require.NoError(t, err)
-		defer cpu.Close()
-
-		scanner := bufio.NewScanner(cpu)
-		scanner.Scan()
-		line := strings.Fields(scanner.Text())
-		userTime, err := strconv.ParseInt(line[1], 10, 64)
-		require.NoError(t, err)
-		niceTime, err := strconv.ParseInt(line[2], 10, 64)
-		require.NoError(t, err)
-		time := niceTime + userTime
-		systemTime, err := strconv.ParseInt(line[3], 10, 64)
+		// Shares of one second, not the cumulative jiffies of /proc/stat.
+		for _, share := range []float64{testData.UserMode, testData.SystemMode, testData.Idle} {
+			require.GreaterOrEqual(t, share, 0.0)
+			require.LessOrEqual(t, share, 100.0)
+		}
+		sum := testData.UserMode + testData.SystemMode + testData.Idle
+		require.Greater(t, sum, 0.0)
+		require.LessOrEqual(t, sum, 100.0+1e-9)
+	})
+	t.Run("NetworkInterfaces", func(t *testing.T) {
+		testData, err := collector.NetDevStat()
 		require.NoError(t, err)
-		idleTime, err := strconv.ParseInt(line[4], 10, 64)
+		names := make([]string, 0, len(testData))
+		for _, iface := range testData {
+			names = append(names, iface.Name)
+		}
+		require.Contains(t, names, "lo")
+	})
+	t.Run("Memory", func(t *testing.T) {
+		testData, err := collector.MemoryStat()
 		require.NoError(t, err)
-		require.Equal(t, float64(time), testData.UserMode)
-		require.Equal(t, float64(systemTime), testData.SystemMode)
-		require.Equal(t, float64(idleTime), testData.Idle)
+		require.NotZero(t, testData.TotalBytes)
+		require.LessOrEqual(t, testData.UsedBytes, testData.TotalBytes)
+		require.Equal(t, testData.TotalBytes,
+			testData.UsedBytes+testData.FreeBytes+testData.BuffersBytes+testData.CachedBytes)
+		require.InDelta(t, float64(testData.UsedBytes)/float64(testData.TotalBytes)*100, testData.UsedPercent, 1e-9)
+		require.LessOrEqual(t, testData.SwapUsedBytes, testData.SwapTotalBytes)
 	})
 	t.Run("Disk", func(t *testing.T) {
 		testData, err := collector.DiskStat()
@@ -68,9 +96,1086 @@ func TestUnitPackage(t *testing.T) {
 		require.NotEmpty(t, TCPStates)
 		require.NotEmpty(t, ListeningSocket)
 	})
+	t.Run("connection addresses", func(t *testing.T) {
+		listener, err := net.Listen("tcp4", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+		conn, err := net.Dial("tcp4", listener.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+		local := conn.LocalAddr().(*net.TCPAddr)
+		remote := listener.Addr().(*net.TCPAddr)
+
+		_, connections, _, _ := collector.TrafficGetInfo()
+		var found bool
+		for _, connection := range connections {
+			if connection.Protocol == "tcp" && connection.SourcePort == local.Port {
+				found = true
+				require.Equal(t, "127.0.0.1", connection.SourceIP)
+				require.Equal(t, "127.0.0.1", connection.DestIP)
+				require.Equal(t, remote.Port, connection.DestPort)
+			}
+		}
+		require.True(t, found)
+
+		listener6, err := net.Listen("tcp6", "[::1]:0")
+		if err != nil {
+			t.Skipf("no IPv6 loopback: %v", err)
+		}
+		defer listener6.Close()
+		conn6, err := net.Dial("tcp6", listener6.Addr().String())
+		require.NoError(t, err)
+		defer conn6.Close()
+		namespaces, err := collector.NamespaceStat()
+		require.NoError(t, err)
+		found = false
+		for _, namespace := range namespaces {
+			for _, connection := range namespace.Connections {
+				if connection.Protocol == "tcp6" && connection.SourcePort == conn6.LocalAddr().(*net.TCPAddr).Port {
+					found = true
+					require.Equal(t, "::1", connection.SourceIP)
+					require.Equal(t, "::1", connection.DestIP)
+				}
+			}
+		}
+		require.True(t, found)
+	})
+	t.Run("connection containers", func(t *testing.T) {
+		listener, err := net.Listen("tcp4", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+		conn, err := net.Dial("tcp4", listener.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+		port := conn.LocalAddr().(*net.TCPAddr).Port
+
+		_, connections, _, _ := collector.TrafficGetInfo()
+		var found bool
+		for _, connection := range connections {
+			if connection.Protocol == "tcp" && connection.SourcePort == port {
+				found = true
+				require.Equal(t, collector.ResolvePID(os.Getpid()), connection.Container)
+			}
+		}
+		require.True(t, found)
+	})
+	t.Run("container cache", func(t *testing.T) {
+		data, err := os.ReadFile("/proc/self/cgroup")
+		require.NoError(t, err)
+		var path string
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			if strings.HasPrefix(line, "0::") {
+				path = strings.TrimPrefix(line, "0::")
+			}
+		}
+		if path == "" {
+			t.Skip("no unified cgroup hierarchy")
+		}
+		// The cached identity is the one /proc/self/cgroup gives, every time.
+		for i := 0; i < 2; i++ {
+			require.Equal(t, collector.ResolveCgroup(path), collector.ResolvePID(os.Getpid()))
+		}
+
+		// Once the process is gone so is its identity.
+		child := exec.Command("sh", "-c", "read line")
+		stdin, err := child.StdinPipe()
+		require.NoError(t, err)
+		require.NoError(t, child.Start())
+		require.Equal(t, collector.ResolveCgroup(path), collector.ResolvePID(child.Process.Pid))
+		_, err = stdin.Write([]byte("\n"))
+		require.NoError(t, err)
+		require.NoError(t, child.Wait())
+		require.Equal(t, collector.ContainerIdentity{}, collector.ResolvePID(child.Process.Pid))
+	})
+	t.Run("kernel activity", func(t *testing.T) {
+		testData, err := collector.KernelStat(true)
+		require.NoError(t, err)
+		require.NotZero(t, testData.BootTime)
+		require.NotZero(t, testData.ProcsRunning)
+		require.NotZero(t, testData.ContextSwitchesPerSec)
+		require.NotEmpty(t, testData.Interrupts)
+		require.NotEmpty(t, testData.SoftIRQs)
+		for _, irq := range testData.SoftIRQs {
+			require.Empty(t, irq.Description)
+		}
+	})
+	t.Run("processes", func(t *testing.T) {
+		testData, err := collector.ProcessStat()
+		require.NoError(t, err)
+		require.NotEmpty(t, testData)
+		var self *collector.ProcessUsage
+		for i := range testData {
+			if testData[i].PID == os.Getpid() {
+				self = &testData[i]
+			}
+		}
+		require.NotNil(t, self)
+		require.Equal(t, os.Getppid(), self.PPID)
+		require.NotZero(t, self.RSSBytes)
+		require.NotZero(t, self.Threads)
+		require.NotZero(t, self.OpenFDs)
+		require.NotEmpty(t, self.Cmdline)
+	})
+	t.Run("watchlist", func(t *testing.T) {
+		pidfile := filepath.Join(t.TempDir(), "self.pid")
+		require.NoError(t, os.WriteFile(pidfile, []byte(strconv.Itoa(os.Getpid())+"\n"), 0o600))
+		watchlist, err := collector.NewWatchlist([]collector.WatchRule{
+			{Name: "web", Comm: "nginx"},
+			{Name: "api", Cmdline: `^python .*api\.py`},
+			{Name: "self", Pidfile: pidfile},
+		})
+		require.NoError(t, err)
+
+		watched := watchlist.Match([]collector.ProcessUsage{
+			{PID: 10, PPID: 1, Command: "nginx", CPUPercent: 1, RSSBytes: 100},
+			{PID: 11, PPID: 10, Command: "nginx", CPUPercent: 2, RSSBytes: 50},
+			{PID: 20, PPID: 1, Command: "python3", Cmdline: "python /srv/api.py"},
+			{PID: os.Getpid(), PPID: 1, Command: "test"},
+		})
+		require.Len(t, watched, 3)
+		require.True(t, watched[0].Alive)
+		require.Equal(t, 10, watched[0].PID)
+		require.Equal(t, []int{10, 11}, watched[0].PIDs)
+		require.Equal(t, 3.0, watched[0].CPUPercent)
+		require.Equal(t, uint64(150), watched[0].RSSBytes)
+		require.Equal(t, 20, watched[1].PID)
+		require.Equal(t, os.Getpid(), watched[2].PID)
+
+		watched = watchlist.Match([]collector.ProcessUsage{
+			{PID: 31, PPID: 30, Command: "nginx"},
+			{PID: 30, PPID: 1, Command: "nginx"},
+		})
+		require.Equal(t, 30, watched[0].PID)
+		require.Equal(t, 1, watched[0].Restarts)
+		require.False(t, watched[1].Alive)
+		require.Zero(t, watched[1].Restarts)
+
+		_, err = collector.NewWatchlist([]collector.WatchRule{{Name: "empty"}})
+		require.Error(t, err)
+		_, err = collector.NewWatchlist([]collector.WatchRule{{Name: "both", Comm: "nginx", Pidfile: pidfile}})
+		require.Error(t, err)
+	})
+	t.Run("process events", func(t *testing.T) {
+		events, err := collector.NewProcEvents(16)
+		if err != nil {
+			t.Skipf("proc connector unavailable: %v", err)
+		}
+		defer events.Close()
+		go events.Run()
+		_, stream, cancel := events.Subscribe(64)
+		defer cancel()
+
+		cmd := exec.Command("sh", "-c", "sleep 0.2; exit 3")
+		require.Error(t, cmd.Run())
+		seen := make(map[string]collector.ProcessEvent)
+		timeout := time.After(5 * time.Second)
+		for seen[collector.EventExit].PID == 0 {
+			select {
+			case event := <-stream:
+				if event.PID == cmd.Process.Pid {
+					seen[event.Type] = event
+				}
+			case <-timeout:
+				t.Fatalf("no exit event for pid %d, got %+v", cmd.Process.Pid, seen)
+			}
+		}
+		require.Equal(t, os.Getpid(), seen[collector.EventFork].PPID)
+		require.Equal(t, "sh", seen[collector.EventExec].Command)
+		require.Equal(t, 3, seen[collector.EventExit].ExitCode)
+		require.NotZero(t, events.Counts().Exits)
+		require.NotEmpty(t, events.History())
+	})
+	t.Run("process states", func(t *testing.T) {
+		zombie := exec.Command("sh", "-c", "exit 0")
+		require.NoError(t, zombie.Start())
+		require.Eventually(t, func() bool {
+			stat, err := os.ReadFile("/proc/" + strconv.Itoa(zombie.Process.Pid) + "/stat")
+			return err == nil && strings.Contains(string(stat), ") Z ")
+		}, 5*time.Second, 10*time.Millisecond)
+		defer zombie.Wait()
+
+		testData, err := collector.ProcessStateStat()
+		require.NoError(t, err)
+		require.NotZero(t, testData.Total)
+		require.GreaterOrEqual(t, testData.Threads, testData.Total)
+		require.NotZero(t, testData.PIDMax)
+		require.NotZero(t, testData.ThreadsMax)
+		require.Greater(t, testData.PIDUsagePercent, 0.0)
+		require.NotZero(t, testData.Zombie)
+		self, err := os.ReadFile("/proc/self/comm")
+		require.NoError(t, err)
+		require.Contains(t, testData.Zombies, collector.ZombieProcess{
+			PID:           zombie.Process.Pid,
+			PPID:          os.Getpid(),
+			Command:       "sh",
+			ParentCommand: strings.TrimSpace(string(self)),
+		})
+	})
+	t.Run("network namespaces", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+		port := listener.Addr().(*net.TCPAddr).Port
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		testData, err := collector.NamespaceStat()
+		require.NoError(t, err)
+		var host *collector.NetworkNamespace
+		for i := range testData {
+			if testData[i].Host {
+				host = &testData[i]
+			}
+		}
+		require.NotNil(t, host)
+		require.NotEmpty(t, host.TCPStates)
+
+		var listening bool
+		for _, socket := range host.Listening {
+			if socket.Port == port {
+				listening = true
+				require.Equal(t, os.Getpid(), socket.PID)
+			}
+		}
+		require.True(t, listening)
+
+		var connected bool
+		for _, c := range host.Connections {
+			if c.DestPort == port {
+				connected = true
+				require.Equal(t, "127.0.0.1", c.DestIP)
+				require.Equal(t, "127.0.0.1", c.SourceIP)
+			}
+		}
+		require.True(t, connected)
+	})
+	t.Run("file descriptors", func(t *testing.T) {
+		// A lowered limit would starve the servers the integration tests leave running in this
+		// process, the check runs in a test process of its own.
+		if os.Getenv("SYSSTATS_FD_TEST") == "" {
+			cmd := exec.Command(os.Args[0], "-test.run", "^TestUnitPackage$/^file_descriptors$")
+			cmd.Env = append(os.Environ(), "SYSSTATS_FD_TEST=1")
+			output, err := cmd.CombinedOutput()
+			require.NoError(t, err, string(output))
+			return
+		}
+		var limit syscall.Rlimit
+		require.NoError(t, syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit))
+		lowered := limit
+		lowered.Cur = 64
+		require.NoError(t, syscall.Setrlimit(syscall.RLIMIT_NOFILE, &lowered))
+		defer syscall.Setrlimit(syscall.RLIMIT_NOFILE, &limit)
+		for i := 0; i < 40; i++ {
+			f, err := os.Open("/proc/self/stat")
+			require.NoError(t, err)
+			defer f.Close()
+		}
+
+		testData, err := collector.FileDescriptorStat(50)
+		require.NoError(t, err)
+		require.NotZero(t, testData.Allocated)
+		require.NotZero(t, testData.Max)
+		require.NotZero(t, testData.Inodes)
+		require.NotZero(t, testData.PIDMax)
+		var self *collector.FDProcess
+		for i := range testData.Processes {
+			require.GreaterOrEqual(t, testData.Processes[i].UsagePercent, 50.0)
+			if testData.Processes[i].PID == os.Getpid() {
+				self = &testData.Processes[i]
+			}
+		}
+		require.NotNil(t, self)
+		require.Equal(t, uint64(64), self.Limit)
+		require.GreaterOrEqual(t, self.OpenFDs, 40)
+	})
+	t.Run("collect status", func(t *testing.T) {
+		testData := collector.Collect(collector.Options{LoadAverage: true, CPU: true})
+		require.Len(t, testData.Status, 2)
+		require.NoError(t, testData.Status[collector.FamilyLoadAverage].Err)
+		require.NoError(t, testData.Status[collector.FamilyCPU].Err)
+		require.Positive(t, testData.Status[collector.FamilyCPU].Duration)
+		require.Empty(t, testData.DiskUsage)
+	})
+	t.Run("host info", func(t *testing.T) {
+		testData, err := collector.HostInfoStat()
+		require.NoError(t, err)
+		hostname, err := os.Hostname()
+		require.NoError(t, err)
+		require.Equal(t, hostname, testData.Hostname)
+		require.NotEmpty(t, testData.KernelRelease)
+		require.NotEmpty(t, testData.Architecture)
+		require.Positive(t, testData.CPUThreads)
+		require.GreaterOrEqual(t, testData.CPUThreads, testData.CPUCores)
+		require.Positive(t, testData.MemoryTotal)
+		require.Positive(t, testData.Uptime)
+		require.True(t, testData.BootTime.Before(time.Now()))
+		require.Equal(t, hostname, testData.Labels()["host"])
+	})
 	t.Run("filesystem slice", func(t *testing.T) {
 		testData := collector.FsStat()
 		require.NotEmpty(t, testData)
 		require.Len(t, testData, len(testData))
 	})
 }
+
+func TestResolveCgroup(t *testing.T) {
+	id := "4f6c1d1b2e3a4f5d6c7b8a9e0f1d2c3b4a5f6e7d8c9b0a1f2e3d4c5b6a7f8e9d"
+	tests := []struct {
+		path     string
+		identity collector.ContainerIdentity
+	}{
+		{
+			path:     "/system.slice/ssh.service",
+			identity: collector.ContainerIdentity{Unit: "ssh.service"},
+		},
+		{
+			path:     "/system.slice/docker-" + id + ".scope",
+			identity: collector.ContainerIdentity{Runtime: "docker", ContainerID: id, Unit: "docker-" + id + ".scope"},
+		},
+		{
+			path:     "/docker/" + id,
+			identity: collector.ContainerIdentity{Runtime: "docker", ContainerID: id},
+		},
+		{
+			path: "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod0a1b2c3d_4e5f_6789_abcd_ef0123456789.slice/cri-containerd-" + id + ".scope",
+			identity: collector.ContainerIdentity{
+				Runtime:     "containerd",
+				ContainerID: id,
+				PodUID:      "0a1b2c3d-4e5f-6789-abcd-ef0123456789",
+				QoSClass:    "burstable",
+				Unit:        "cri-containerd-" + id + ".scope",
+			},
+		},
+		{
+			path: "/kubepods.slice/kubepods-pod0a1b2c3d_4e5f_6789_abcd_ef0123456789.slice/crio-" + id + ".scope",
+			identity: collector.ContainerIdentity{
+				Runtime:     "cri-o",
+				ContainerID: id,
+				PodUID:      "0a1b2c3d-4e5f-6789-abcd-ef0123456789",
+				QoSClass:    "guaranteed",
+				Unit:        "crio-" + id + ".scope",
+			},
+		},
+		{
+			path: "/kubepods/besteffort/pod0a1b2c3d-4e5f-6789-abcd-ef0123456789/" + id,
+			identity: collector.ContainerIdentity{
+				ContainerID: id,
+				PodUID:      "0a1b2c3d-4e5f-6789-abcd-ef0123456789",
+				QoSClass:    "besteffort",
+			},
+		},
+		{
+			path:     "/machine.slice/libpod-" + id + ".scope/container",
+			identity: collector.ContainerIdentity{Runtime: "podman", ContainerID: id, Unit: "libpod-" + id + ".scope"},
+		},
+		{
+			path:     "/machine.slice/libpod-conmon-" + id + ".scope",
+			identity: collector.ContainerIdentity{Unit: "libpod-conmon-" + id + ".scope"},
+		},
+		{
+			path:     "/",
+			identity: collector.ContainerIdentity{},
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.identity, collector.ResolveCgroup(tc.path), tc.path)
+	}
+}
+
+func TestSysfsFixtures(t *testing.T) {
+	sysRoot := collector.SysRoot
+	collector.SysRoot = "testdata/sys"
+	defer func() { collector.SysRoot = sysRoot }()
+
+	t.Run("cgroups", func(t *testing.T) {
+		testData, err := collector.CgroupStat(2, []string{"system.slice/*.service", "kubepods/*"})
+		require.NoError(t, err)
+		paths := make([]string, 0, len(testData))
+		for _, cgroup := range testData {
+			paths = append(paths, cgroup.Path)
+		}
+		require.Equal(t, []string{"kubepods/pod1", "system.slice/cron.service", "system.slice/ssh.service"}, paths)
+
+		ssh := testData[2]
+		require.Equal(t, uint64(10485760), ssh.MemoryBytes)
+		require.Equal(t, uint64(4194304), ssh.MemoryAnon)
+		require.Equal(t, uint64(6291456), ssh.MemoryFile)
+		require.Equal(t, uint64(3), ssh.Pids)
+		require.Zero(t, ssh.CPUPercent)
+		require.Equal(t, []collector.Pressure{{
+			Resource:  "cpu",
+			SomeAvg10: 1.5, SomeAvg60: 0.75, SomeAvg300: 0.1,
+			FullAvg10: 0.5, FullAvg60: 0.25, FullAvg300: 0.05,
+		}}, ssh.Pressure)
+
+		testData, err = collector.CgroupStat(0, nil)
+		require.NoError(t, err)
+		require.Len(t, testData, 7)
+		require.True(t, collector.Supported(collector.FamilyCgroups))
+	})
+	t.Run("sensors", func(t *testing.T) {
+		testData := collector.SensorStat()
+		require.Equal(t, []collector.ThermalZone{
+			{Zone: "thermal_zone0", Type: "x86_pkg_temp", Celsius: 45},
+		}, testData.ThermalZones)
+		require.Equal(t, []collector.HwmonSensor{
+			{Chip: "coretemp", Device: "hwmon0", Sensor: "temp1", Label: "Package id 0", Kind: "temperature", Value: 52},
+			{Chip: "coretemp", Device: "hwmon0", Sensor: "fan1", Kind: "fan", Value: 1250},
+			{Chip: "coretemp", Device: "hwmon0", Sensor: "in0", Label: "Vcore", Kind: "voltage", Value: 1.2},
+			{Chip: "nvme", Device: "hwmon1", Sensor: "temp1", Kind: "temperature", Value: 38.85},
+			{Chip: "nvme", Device: "hwmon2", Sensor: "temp1", Kind: "temperature", Value: 41},
+		}, testData.Hwmon)
+
+		// Two drives of the same driver stay two series.
+		sensors := &collectorpb.Sensors{}
+		for _, sensor := range testData.Hwmon {
+			sensors.Hwmon = append(sensors.Hwmon, &collectorpb.HwmonSensor{
+				Chip: sensor.Chip, Device: sensor.Device, Sensor: sensor.Sensor, Kind: sensor.Kind, Value: sensor.Value,
+			})
+		}
+		families := exporter.Families(&collectorpb.MetricsResponse{Collector: &collectorpb.Collector{Sensors: sensors}}, nil)
+		var text bytes.Buffer
+		require.NoError(t, exporter.WritePrometheus(&text, families, false))
+		require.Contains(t, text.String(), `sysstats_hwmon_temperature_celsius{chip="nvme",device="hwmon1",sensor="temp1",label=""} 38.85`)
+		require.Contains(t, text.String(), `sysstats_hwmon_temperature_celsius{chip="nvme",device="hwmon2",sensor="temp1",label=""} 41`)
+		require.Equal(t, []collector.CPUFrequency{
+			{CPU: "cpu0", CurrentMHz: 2400, MinMHz: 800, MaxMHz: 3600, CoreThrottles: 12, PackageThrottles: 3},
+			{CPU: "cpu1", CurrentMHz: 1800},
+		}, testData.CPUFrequency)
+		require.True(t, collector.Supported(collector.FamilySensors))
+	})
+	t.Run("sensors absent", func(t *testing.T) {
+		collector.SysRoot = t.TempDir()
+		defer func() { collector.SysRoot = "testdata/sys" }()
+		testData := collector.SensorStat()
+		require.Empty(t, testData.ThermalZones)
+		require.Empty(t, testData.Hwmon)
+		require.Empty(t, testData.CPUFrequency)
+		require.False(t, collector.Supported(collector.FamilySensors))
+		require.False(t, collector.Supported(collector.FamilyCgroups))
+	})
+}
+
+func TestDeltaReader(t *testing.T) {
+	conn := func(port int64, bytes int64) *collectorpb.TrafficInfo {
+		return &collectorpb.TrafficInfo{Protocol: "tcp", Sourceip: "127.0.0.1", SourcePort: port, Destip: "127.0.0.1", DestPort: 80, Bytes: bytes}
+	}
+	reader := client.NewDeltaReader(nil)
+
+	require.ErrorIs(t, reader.Apply(&collectorpb.MetricsResponse{Delta: &collectorpb.CollectorDelta{}}), client.ErrNoKeyframe)
+
+	keyframe := &collectorpb.MetricsResponse{
+		Keyframe:  true,
+		Collector: &collectorpb.Collector{Trafficinfo: []*collectorpb.TrafficInfo{conn(1000, 1), conn(1001, 1), conn(1002, 1)}},
+	}
+	require.NoError(t, reader.Apply(keyframe))
+	require.Len(t, keyframe.GetCollector().GetTrafficinfo(), 3)
+
+	delta := &collectorpb.MetricsResponse{
+		Collector: &collectorpb.Collector{},
+		Delta: &collectorpb.CollectorDelta{
+			Trafficinfo: &collectorpb.TrafficInfoDelta{
+				Upserted: []*collectorpb.TrafficInfo{conn(1001, 5), conn(1003, 1)},
+				Removed:  []string{client.TrafficKey(conn(1000, 0))},
+			},
+		},
+	}
+	require.NoError(t, reader.Apply(delta))
+	require.Nil(t, delta.GetDelta())
+	require.Equal(t, []*collectorpb.TrafficInfo{conn(1001, 5), conn(1002, 1), conn(1003, 1)}, delta.GetCollector().GetTrafficinfo())
+
+	closing := conn(1002, 1)
+	closing.State = "08"
+	delta = &collectorpb.MetricsResponse{
+		Collector: &collectorpb.Collector{},
+		Delta: &collectorpb.CollectorDelta{
+			Trafficinfo: &collectorpb.TrafficInfoDelta{Upserted: []*collectorpb.TrafficInfo{closing}},
+		},
+	}
+	require.NoError(t, reader.Apply(delta))
+	require.Equal(t, []*collectorpb.TrafficInfo{conn(1001, 5), conn(1002, 1), conn(1003, 1), closing}, delta.GetCollector().GetTrafficinfo())
+}
+
+func TestStorage(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+	point := func(second int) *collectorpb.MetricsResponse {
+		at := timestamppb.New(start.Add(time.Duration(second) * time.Second))
+		return &collectorpb.MetricsResponse{
+			WindowStart: at,
+			WindowEnd:   at,
+			Samples:     1,
+			Collector:   &collectorpb.Collector{Loadaverage: &collectorpb.LoadAverage{OneMinute: float64(second)}},
+		}
+	}
+	opts := storage.Options{
+		Path:  dir,
+		Tiers: []storage.Tier{{Name: storage.TierRaw, Retention: time.Hour, BlockSpan: 10 * time.Second}},
+	}
+	query := func(store *storage.Store) []float64 {
+		points, err := store.Query(storage.TierRaw, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		values := make([]float64, 0, len(points))
+		for _, p := range points {
+			values = append(values, p.GetCollector().GetLoadaverage().GetOneMinute())
+		}
+		return values
+	}
+
+	store, err := storage.Open(opts)
+	require.NoError(t, err)
+	for second := 0; second < 25; second++ {
+		require.NoError(t, store.Append(storage.TierRaw, point(second)))
+	}
+	blocks, err := filepath.Glob(filepath.Join(dir, storage.TierRaw, "*.blk"))
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	require.Len(t, query(store), 25)
+	require.NoError(t, store.Close())
+
+	t.Run("torn write", func(t *testing.T) {
+		wal, err := os.OpenFile(filepath.Join(dir, storage.TierRaw, "wal"), os.O_APPEND|os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = wal.Write([]byte{0x20, 0, 0, 0, 1, 2})
+		require.NoError(t, err)
+		require.NoError(t, wal.Close())
+
+		store, err := storage.Open(opts)
+		require.NoError(t, err)
+		defer store.Close()
+		require.Len(t, query(store), 25)
+		require.NoError(t, store.Append(storage.TierRaw, point(25)))
+		values := query(store)
+		require.Len(t, values, 26)
+		require.Equal(t, 25.0, values[25])
+	})
+	t.Run("retention", func(t *testing.T) {
+		store, err := storage.Open(opts)
+		require.NoError(t, err)
+		defer store.Close()
+		require.NoError(t, store.Append(storage.TierRaw, point(2*3600)))
+		require.NoError(t, store.Append(storage.TierRaw, point(2*3600+20)))
+		require.Equal(t, []float64{2 * 3600, 2*3600 + 20}, query(store))
+		oldest, ok := store.Oldest(storage.TierRaw)
+		require.True(t, ok)
+		require.True(t, start.Add(2*time.Hour).Equal(oldest))
+	})
+	t.Run("queries while appending", func(t *testing.T) {
+		dir := t.TempDir()
+		store, err := storage.Open(storage.Options{
+			Path:         dir,
+			Tiers:        []storage.Tier{{Name: storage.TierRaw, Retention: time.Hour, BlockSpan: 10 * time.Second}},
+			SyncInterval: 10 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for second := 0; second < 100; second++ {
+				require.NoError(t, store.Append(storage.TierRaw, point(second)))
+			}
+		}()
+		seen := 0
+		for finished := false; !finished; {
+			select {
+			case <-done:
+				finished = true
+			default:
+			}
+			values := query(store)
+			require.GreaterOrEqual(t, len(values), seen)
+			for i, value := range values {
+				require.Equal(t, float64(i), value)
+			}
+			seen = len(values)
+		}
+		require.Len(t, query(store), 100)
+
+		// Closing syncs what the loop has not yet.
+		require.NoError(t, store.Append(storage.TierRaw, point(100)))
+		require.NoError(t, store.Close())
+		store, err = storage.Open(storage.Options{
+			Path:  dir,
+			Tiers: []storage.Tier{{Name: storage.TierRaw, Retention: time.Hour, BlockSpan: 10 * time.Second}},
+		})
+		require.NoError(t, err)
+		defer store.Close()
+		require.Len(t, query(store), 101)
+	})
+}
+
+func TestPrometheusFormat(t *testing.T) {
+	cpu, err := collector.CpuStat()
+	require.NoError(t, err)
+	resp := &collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Cpuusage: &collectorpb.CPUUsage{UserMode: cpu.UserMode, SystemMode: cpu.SystemMode, Idle: cpu.Idle},
+		Filesystemusage: []*collectorpb.FileSystemUsage{
+			{FileSystem: "/dev/sda1", MountPoint: `/mnt/"data"`, Usedmb: 2},
+		},
+		Kernelactivity: &collectorpb.KernelActivity{
+			Irqs: []*collectorpb.Interrupt{{Name: "0", Description: "timer", Count: 42}},
+		},
+	}}
+	families := exporter.Families(resp, map[string]string{"host": "node1", "os": ""})
+
+	var text bytes.Buffer
+	require.NoError(t, exporter.WritePrometheus(&text, families, false))
+	require.Contains(t, text.String(), "# HELP sysstats_cpu_usage_ratio Share of CPU time by mode.\n# TYPE sysstats_cpu_usage_ratio gauge\n")
+	for mode, share := range map[string]float64{"user": cpu.UserMode, "system": cpu.SystemMode, "idle": cpu.Idle} {
+		prefix := `sysstats_cpu_usage_ratio{mode="` + mode + `",host="node1"} `
+		start := strings.Index(text.String(), prefix)
+		require.GreaterOrEqual(t, start, 0, mode)
+		line, _, _ := strings.Cut(text.String()[start+len(prefix):], "\n")
+		ratio, err := strconv.ParseFloat(line, 64)
+		require.NoError(t, err)
+		require.InDelta(t, share/100, ratio, 1e-9, mode)
+		require.LessOrEqual(t, ratio, 1.0, mode)
+	}
+	require.Contains(t, text.String(), `sysstats_filesystem_used_bytes{device="/dev/sda1",mountpoint="/mnt/\"data\"",host="node1"} 2.097152e+06`)
+	require.Contains(t, text.String(), "# TYPE sysstats_interrupts_total counter\n")
+	require.Contains(t, text.String(), `sysstats_interrupts_total{irq="0",description="timer",host="node1"} 42`)
+	require.NotContains(t, text.String(), "# UNIT")
+	require.NotContains(t, text.String(), "# EOF")
+
+	var openMetrics bytes.Buffer
+	require.NoError(t, exporter.WritePrometheus(&openMetrics, families, true))
+	require.Contains(t, openMetrics.String(), "# TYPE sysstats_interrupts counter\n")
+	require.Contains(t, openMetrics.String(), "# UNIT sysstats_cpu_usage_ratio ratio\n")
+	require.Contains(t, openMetrics.String(), `sysstats_interrupts_total{irq="0",description="timer",host="node1"} 42`)
+	require.True(t, strings.HasSuffix(openMetrics.String(), "# EOF\n"))
+}
+
+func TestNetworkFamilies(t *testing.T) {
+	resp := &collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Networkinterface: []*collectorpb.NetworkInterface{
+			{Name: "eth0", RxBytes: 1000, RxPackets: 10, TxBytes: 2000, TxPackets: 20, TxDropped: 1},
+		},
+		Trafficinfo: []*collectorpb.TrafficInfo{
+			{Protocol: "tcp", Sourceip: "10.0.0.1", SourcePort: 22, Destip: "10.0.0.2", DestPort: 5000, Bytes: 512, Bps: 5e-7, State: "01"},
+		},
+	}}
+
+	var text bytes.Buffer
+	require.NoError(t, exporter.WritePrometheus(&text, exporter.Families(resp, nil), false))
+	require.Contains(t, text.String(), "# TYPE sysstats_network_bytes_total counter\n")
+	require.Contains(t, text.String(), `sysstats_network_bytes_total{interface="eth0",direction="receive"} 1000`)
+	require.Contains(t, text.String(), `sysstats_network_bytes_total{interface="eth0",direction="transmit"} 2000`)
+	require.Contains(t, text.String(), `sysstats_network_packets_total{interface="eth0",direction="transmit"} 20`)
+	require.Contains(t, text.String(), `sysstats_network_dropped_total{interface="eth0",direction="transmit"} 1`)
+	require.Contains(t, text.String(),
+		`sysstats_connection_queued_bytes{protocol="tcp",source="10.0.0.1:22",destination="10.0.0.2:5000",state="01"} 512`)
+	require.NotContains(t, text.String(), "per_second{protocol")
+
+	metrics := exporter.OTLPMetrics(resp, collector.HostInfo{}).GetResourceMetrics()[0].GetScopeMetrics()[0].GetMetrics()
+	var networkIO *metricspb.Metric
+	for _, metric := range metrics {
+		if metric.GetName() == "system.network.io" {
+			networkIO = metric
+		}
+	}
+	require.NotNil(t, networkIO)
+	require.Equal(t, "By", networkIO.GetUnit())
+	require.True(t, networkIO.GetSum().GetIsMonotonic())
+	point := networkIO.GetSum().GetDataPoints()[0]
+	require.Equal(t, 1000.0, point.GetAsDouble())
+	require.Equal(t, "network.interface.name", point.GetAttributes()[0].GetKey())
+	require.Equal(t, "network.io.direction", point.GetAttributes()[1].GetKey())
+	require.Equal(t, "receive", point.GetAttributes()[1].GetValue().GetStringValue())
+}
+
+func TestOTLPHTTPRetry(t *testing.T) {
+	var (
+		attempts int
+		received = make(chan *metricsservice.ExportMetricsServiceRequest, 1)
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/metrics", r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req metricsservice.ExportMetricsServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+		received <- &req
+	}))
+	defer receiver.Close()
+
+	sink, err := exporter.NewOTLPSink(exporter.OTLPConfig{Endpoint: receiver.URL, Protocol: exporter.ProtocolHTTP}, collector.HostInfo{Hostname: "node1"})
+	require.NoError(t, err)
+	resp := &collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Filesystemusage: []*collectorpb.FileSystemUsage{{FileSystem: "/dev/sda1", MountPoint: "/", Usedmb: 1}},
+	}}
+	pusher := exporter.NewPusher("otlp", func(context.Context) (*collectorpb.MetricsResponse, error) {
+		return resp, nil
+	}, sink, 100*time.Millisecond, 1)
+	pusher.Backoff = exporter.Backoff{Initial: 10 * time.Millisecond, Max: 10 * time.Millisecond, MaxElapsed: time.Second}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go pusher.Run(ctx)
+
+	select {
+	case req := <-received:
+		resource := req.GetResourceMetrics()[0]
+		require.Equal(t, "host.name", resource.GetResource().GetAttributes()[1].GetKey())
+		require.Equal(t, "node1", resource.GetResource().GetAttributes()[1].GetValue().GetStringValue())
+		metric := resource.GetScopeMetrics()[0].GetMetrics()[0]
+		require.Equal(t, "system.filesystem.usage", metric.GetName())
+		require.Equal(t, "By", metric.GetUnit())
+		// Usage is an UpDownCounter in the conventions, not a gauge.
+		require.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, metric.GetSum().GetAggregationTemporality())
+		require.False(t, metric.GetSum().GetIsMonotonic())
+		point := metric.GetSum().GetDataPoints()[0]
+		require.Equal(t, float64(1024*1024), point.GetAsDouble())
+		require.Equal(t, "system.filesystem.mountpoint", point.GetAttributes()[1].GetKey())
+		for _, metric := range resource.GetScopeMetrics()[0].GetMetrics() {
+			switch metric.GetName() {
+			case "system.filesystem.inodes.usage":
+				require.False(t, metric.GetSum().GetIsMonotonic())
+			case "system.filesystem.utilization":
+				require.NotNil(t, metric.GetGauge())
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no metrics after a retry")
+	}
+}
+
+func TestLineProtocolSinks(t *testing.T) {
+	resp := &collectorpb.MetricsResponse{
+		WindowEnd: timestamppb.New(time.Unix(1700000000, 0)),
+		Collector: &collectorpb.Collector{
+			Cpuusage: &collectorpb.CPUUsage{UserMode: 25, SystemMode: 5, Idle: 70},
+			Filesystemusage: []*collectorpb.FileSystemUsage{
+				{FileSystem: "/dev/sda1", MountPoint: "/var/lib data", Usedmb: 1},
+			},
+		},
+	}
+	hostLabels := map[string]string{"host": "node1", "machine_id": "abc"}
+	ctx := context.Background()
+
+	t.Run("influx http", func(t *testing.T) {
+		var requests []string
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Token secret", r.Header.Get("Authorization"))
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			requests = append(requests, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		sink, err := exporter.NewInfluxSink(exporter.InfluxConfig{
+			URL:       receiver.URL + "/api/v2/write?org=ops&bucket=hosts",
+			Token:     "secret",
+			Prefix:    "sys_",
+			Tags:      map[string]string{"mountpoint": "path", "machine_id": ""},
+			BatchSize: 4,
+		}, hostLabels)
+		require.NoError(t, err)
+		require.NoError(t, sink.Send(ctx, resp))
+		require.Len(t, requests, 2)
+		require.True(t, strings.HasPrefix(requests[0], "sys_cpu_usage_ratio,host=node1,mode=user value=0.25 1700000000000000000\n"))
+		require.True(t, strings.HasPrefix(requests[1], "sys_filesystem_used_ratio,"))
+		require.Contains(t, requests[0], `sys_filesystem_used_bytes,device=/dev/sda1,host=node1,path=/var/lib\ data value=1.048576e+06 1700000000000000000`)
+	})
+
+	t.Run("influx udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		sink, err := exporter.NewInfluxSink(exporter.InfluxConfig{URL: "udp://" + conn.LocalAddr().String()}, nil)
+		require.NoError(t, err)
+		defer sink.Close()
+		require.NoError(t, sink.Send(ctx, resp))
+
+		buf := make([]byte, 2048)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(buf[:n]), "cpu_usage_ratio,mode=user value=0.25 1700000000000000000\n"))
+	})
+
+	t.Run("graphite", func(t *testing.T) {
+		for _, tc := range []struct {
+			tagged bool
+			line   string
+		}{
+			{false, "servers.node1.filesystem_used_bytes.dev_sda1.var_lib_data.node1.abc 1.048576e+06 1700000000\n"},
+			{true, "servers.node1.filesystem_used_bytes;device=/dev/sda1;mountpoint=/var/lib_data;host=node1;machine_id=abc 1.048576e+06 1700000000\n"},
+		} {
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			received := make(chan string, 1)
+			go func() {
+				conn, err := lis.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+				body, _ := io.ReadAll(conn)
+				received <- string(body)
+			}()
+
+			sink, err := exporter.NewGraphiteSink(exporter.GraphiteConfig{
+				Address: lis.Addr().String(),
+				Prefix:  "servers.node1",
+				Tagged:  tc.tagged,
+			}, hostLabels)
+			require.NoError(t, err)
+			require.NoError(t, sink.Send(ctx, resp))
+			require.Contains(t, <-received, tc.line)
+			lis.Close()
+		}
+	})
+}
+
+func TestStatsD(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "statsd.sock")
+	statsd, err := collector.NewStatsD("", socket, time.Second, 5)
+	require.NoError(t, err)
+	defer statsd.Close()
+
+	for _, line := range []string{
+		"requests:1|c|#route:/login,env:prod",
+		"requests:1|c|@0.5|#env:prod,route:/login",
+		"queue:10|g",
+		"queue:-3|g",
+		"latency:10:20:30:40|ms",
+		"users:alice|s",
+		"users:bob|s",
+		"users:alice|s",
+		"_e{5,4}:title|text",
+		"broken|c",
+		"size:7|h",
+		"overflow:1|c",
+	} {
+		statsd.Ingest(line)
+	}
+	statsd.Flush()
+
+	snapshot := statsd.Snapshot()
+	require.Equal(t, uint64(1), snapshot.Dropped)
+	metrics := make(map[string]collector.StatsDMetric)
+	for _, metric := range snapshot.Metrics {
+		metrics[metric.Name] = metric
+	}
+	require.Len(t, metrics, 5)
+	require.Equal(t, []string{"env:prod", "route:/login"}, metrics["requests"].Tags)
+	require.Equal(t, 3.0, metrics["requests"].Value)
+	require.Equal(t, 3.0, metrics["requests"].Rate)
+	require.Equal(t, 7.0, metrics["queue"].Value)
+	require.Equal(t, 2.0, metrics["users"].Value)
+	latency := metrics["latency"]
+	require.Equal(t, collector.StatsDTimer, latency.Type)
+	require.Equal(t, uint64(4), latency.Count)
+	require.Equal(t, 10.0, latency.Min)
+	require.Equal(t, 40.0, latency.Max)
+	require.Equal(t, 25.0, latency.Mean)
+	require.Equal(t, 20.0, latency.P50)
+	require.Equal(t, 40.0, latency.P99)
+
+	families := exporter.Families(&collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Statsd: &collectorpb.StatsD{Metrics: []*collectorpb.StatsDMetric{
+			{Name: "requests", Type: collector.StatsDCounter, Tags: []string{"env:prod", "canary"}, Rate: 3},
+		}},
+	}}, nil)
+	require.Equal(t, "sysstats_statsd_counter_requests_per_second", families[0].Name)
+	require.Equal(t, []exporter.Label{{Name: "env", Value: "prod"}, {Name: "canary", Value: "true"}}, families[0].Samples[0].Labels)
+
+	// Tags never override the host labels or the quantile and always make valid label names.
+	families = exporter.Families(&collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Statsd: &collectorpb.StatsD{Metrics: []*collectorpb.StatsDMetric{{
+			Name: "latency", Type: collector.StatsDTimer, Tags: []string{"host:web1", "quantile:x", "1st:a", "__name__:b", "env:a", "env:b"},
+			Count: 4, Sum: 100, P50: 20, P90: 40, P95: 40, P99: 40,
+		}}},
+	}}, map[string]string{"host": "node1"})
+	var text bytes.Buffer
+	require.NoError(t, exporter.WritePrometheus(&text, families, false))
+	labels := `tag_host="web1",tag_quantile="x",tag_1st="a",tag___name__="b",env="a",host="node1"`
+	require.Contains(t, text.String(), "# TYPE sysstats_statsd_timer_latency summary\n"+
+		`sysstats_statsd_timer_latency{quantile="0.5",`+labels+`} 20`+"\n")
+	require.Contains(t, text.String(), `sysstats_statsd_timer_latency_sum{`+labels+`} 100`+"\n")
+	require.Contains(t, text.String(), `sysstats_statsd_timer_latency_count{`+labels+`} 4`+"\n")
+
+	otlp := exporter.OTLPMetrics(&collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Statsd: &collectorpb.StatsD{Metrics: []*collectorpb.StatsDMetric{{
+			Name: "latency", Type: collector.StatsDTimer, Count: 4, Sum: 100, P50: 20, P90: 40, P95: 40, P99: 40,
+		}}},
+	}}, collector.HostInfo{})
+	summary := otlp.GetResourceMetrics()[0].GetScopeMetrics()[0].GetMetrics()[0].GetSummary()
+	require.Len(t, summary.GetDataPoints(), 1)
+	require.Equal(t, uint64(4), summary.GetDataPoints()[0].GetCount())
+	require.Equal(t, 100.0, summary.GetDataPoints()[0].GetSum())
+	require.Len(t, summary.GetDataPoints()[0].GetQuantileValues(), 4)
+
+	// Gauges outlive the interval, everything else starts over.
+	statsd.Flush()
+	require.Len(t, statsd.Snapshot().Metrics, 1)
+
+	// A gauge without updates expires after 30 intervals and frees its place under the limit.
+	for i := 0; i < 28; i++ {
+		statsd.Flush()
+	}
+	require.Len(t, statsd.Snapshot().Metrics, 1)
+	statsd.Flush()
+	require.Empty(t, statsd.Snapshot().Metrics)
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		statsd.Ingest(name + ":1|c")
+	}
+	statsd.Flush()
+	require.Len(t, statsd.Snapshot().Metrics, 5)
+	require.Equal(t, uint64(1), statsd.Snapshot().Dropped)
+
+	go statsd.Run()
+	conn, err := net.Dial("unixgram", socket)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("jobs:2|c\njobs:3|c"))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		for _, metric := range statsd.Snapshot().Metrics {
+			if metric.Name == "jobs" {
+				return metric.Value == 5
+			}
+		}
+		return false
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestStatsDAggregates(t *testing.T) {
+	statsd, err := collector.NewStatsD("", filepath.Join(t.TempDir(), "statsd.sock"), time.Second, 0)
+	require.NoError(t, err)
+	defer statsd.Close()
+
+	statsd.Ingest("errors:-2|c")
+	statsd.Ingest("latency:10|ms|@0.5")
+	statsd.Ingest("latency:20|ms")
+	for i := 0; i < 5000; i++ {
+		statsd.Ingest("size:" + strconv.Itoa(i) + "|h")
+	}
+	statsd.Flush()
+	metrics := make(map[string]collector.StatsDMetric)
+	for _, metric := range statsd.Snapshot().Metrics {
+		metrics[metric.Name] = metric
+	}
+
+	// A negative counter keeps its value but has no count.
+	require.Equal(t, -2.0, metrics["errors"].Value)
+	require.Equal(t, uint64(0), metrics["errors"].Count)
+	// Count and Sum are both corrected by the sample rate.
+	require.Equal(t, uint64(3), metrics["latency"].Count)
+	require.Equal(t, 40.0, metrics["latency"].Sum)
+	require.InDelta(t, 40.0/3, metrics["latency"].Mean, 1e-9)
+	// Past the sample cap the extremes, count and sum still cover every value.
+	size := metrics["size"]
+	require.Equal(t, uint64(5000), size.Count)
+	require.Equal(t, 0.0, size.Min)
+	require.Equal(t, 4999.0, size.Max)
+	require.Equal(t, 4999.0*5000/2, size.Sum)
+	require.InDelta(t, 2500, size.P50, 500)
+
+	// Every family name has a single type and a single StatsD name behind it.
+	families := exporter.Families(&collectorpb.MetricsResponse{Collector: &collectorpb.Collector{
+		Statsd: &collectorpb.StatsD{Metrics: []*collectorpb.StatsDMetric{
+			{Name: "a.b", Type: collector.StatsDGauge, Value: 1},
+			{Name: "a_b", Type: collector.StatsDGauge, Value: 2},
+			{Name: "dropped", Type: collector.StatsDCounter, Rate: 3},
+			{Name: "foo", Type: collector.StatsDCounter, Rate: 4},
+			{Name: "foo", Type: collector.StatsDGauge, Value: 5},
+			{Name: "foo", Type: collector.StatsDTimer, Count: 1, Sum: 6, Rate: 7},
+			{Name: "foo_per_second", Type: collector.StatsDTimer, Count: 1, Sum: 8},
+		}, Dropped: 9},
+	}}, nil)
+	byName := make(map[string]exporter.Family)
+	for _, family := range families {
+		byName[family.Name] = family
+	}
+	require.Len(t, byName, len(families))
+	require.Len(t, byName["sysstats_statsd_gauge_a_b"].Samples, 1)
+	require.Equal(t, 1.0, byName["sysstats_statsd_gauge_a_b"].Samples[0].Value)
+	require.Equal(t, 3.0, byName["sysstats_statsd_counter_dropped_per_second"].Samples[0].Value)
+	require.Equal(t, 9.0, byName["sysstats_statsd_dropped"].Samples[0].Value)
+	require.Equal(t, 4.0, byName["sysstats_statsd_counter_foo_per_second"].Samples[0].Value)
+	require.Equal(t, 5.0, byName["sysstats_statsd_gauge_foo"].Samples[0].Value)
+	require.Equal(t, exporter.TypeSummary, byName["sysstats_statsd_timer_foo"].Type)
+	require.Len(t, byName["sysstats_statsd_timer_foo_per_second"].Samples, 1)
+	require.Equal(t, 7.0, byName["sysstats_statsd_timer_foo_per_second"].Samples[0].Value)
+}
+
+func TestRecordSink(t *testing.T) {
+	resp := &collectorpb.MetricsResponse{
+		WindowEnd: timestamppb.New(time.Unix(1700000000, 0)),
+		Collector: &collectorpb.Collector{
+			Loadaverage: &collectorpb.LoadAverage{OneMinute: 0.5},
+			Filesystemusage: []*collectorpb.FileSystemUsage{
+				{FileSystem: "/dev/sda1", MountPoint: "/", Usedmb: 1.5},
+				{FileSystem: "/dev/sdb1", MountPoint: "/data, archive", Usedmb: 2},
+			},
+			Kernelactivity: &collectorpb.KernelActivity{
+				ContextSwitches: 100,
+				Irqs:            []*collectorpb.Interrupt{{Name: "0", Description: "timer", Count: 42}},
+			},
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("json lines", func(t *testing.T) {
+		dir := t.TempDir()
+		sink, err := exporter.NewRecordSink(exporter.RecordConfig{Path: dir, MaxSize: 1, Compress: true, MaxFiles: 2})
+		require.NoError(t, err)
+		for i := 0; i < 4; i++ {
+			require.NoError(t, sink.Send(ctx, resp))
+		}
+		require.NoError(t, sink.Close())
+
+		rotated, err := filepath.Glob(filepath.Join(dir, "metrics-*.jsonl.gz"))
+		require.NoError(t, err)
+		require.Len(t, rotated, 2)
+		file, err := os.Open(rotated[0])
+		require.NoError(t, err)
+		defer file.Close()
+		gz, err := gzip.NewReader(file)
+		require.NoError(t, err)
+		line, err := io.ReadAll(gz)
+		require.NoError(t, err)
+		var recorded collectorpb.MetricsResponse
+		require.NoError(t, protojson.Unmarshal(bytes.TrimSpace(line), &recorded))
+		require.True(t, proto.Equal(resp, &recorded))
+
+		current, err := os.ReadFile(filepath.Join(dir, "metrics.jsonl"))
+		require.NoError(t, err)
+		require.Equal(t, 1, bytes.Count(current, []byte("\n")))
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		dir := t.TempDir()
+		sink, err := exporter.NewRecordSink(exporter.RecordConfig{Path: dir, Format: exporter.FormatCSV})
+		require.NoError(t, err)
+		require.NoError(t, sink.Send(ctx, resp))
+		require.NoError(t, sink.Send(ctx, resp))
+		require.NoError(t, sink.Close())
+
+		filesystems, err := os.ReadFile(filepath.Join(dir, "filesystemusage.csv"))
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(filesystems)), "\n")
+		require.Len(t, lines, 5)
+		require.Equal(t, "time,file_system,usedmb,used_percent,used_inode,inode_percent,mount_point", lines[0])
+		require.Equal(t, `2023-11-14T22:13:20Z,/dev/sdb1,2,0,0,0,"/data, archive"`, lines[2])
+
+		kernel, err := os.ReadFile(filepath.Join(dir, "kernelactivity.csv"))
+		require.NoError(t, err)
+		require.Contains(t, string(kernel), "time,context_switches,")
+		irqs, err := os.ReadFile(filepath.Join(dir, "kernelactivity_irqs.csv"))
+		require.NoError(t, err)
+		require.Contains(t, string(irqs), "2023-11-14T22:13:20Z,0,timer,42,")
+		require.FileExists(t, filepath.Join(dir, "loadaverage.csv"))
+	})
+}